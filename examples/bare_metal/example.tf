@@ -35,4 +35,9 @@ resource "vultr_bare_metal" "example" {
   region_id = data.vultr_region.silicon_valley.id
   plan_id   = data.vultr_bare_metal_plan.eightcpus.id
   os_id     = data.vultr_os.container_linux.id
+
+  // Installing a bare metal instance can take a long time.
+  timeouts {
+    create = "90m"
+  }
 }
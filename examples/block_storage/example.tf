@@ -18,3 +18,26 @@ resource "vultr_block_storage" "example" {
   region_id = data.vultr_region.has_block_storage.id
   size      = 50
 }
+
+// Find the ID for CoreOS Container Linux.
+data "vultr_os" "container_linux" {
+  filter {
+    name   = "family"
+    values = ["coreos"]
+  }
+}
+
+// Create a Vultr virtual machine in the same region.
+resource "vultr_instance" "example" {
+  name      = "example"
+  region_id = data.vultr_region.has_block_storage.id
+  plan_id   = 201
+  os_id     = data.vultr_os.container_linux.id
+}
+
+// Attach the block storage to the virtual machine without restarting it.
+resource "vultr_block_storage_attachment" "example" {
+  block_storage_id = vultr_block_storage.example.id
+  instance_id      = vultr_instance.example.id
+  live             = true
+}
@@ -18,3 +18,15 @@ resource "vultr_dns_record" "example_web" {
   data   = vultr_dns_domain.example.ip
   ttl    = 300
 }
+
+// Create a CAA record that only allows Let's Encrypt to issue certificates for the domain.
+resource "vultr_dns_record" "example_caa" {
+  domain = vultr_dns_domain.example.id
+  name   = ""
+  type   = "CAA"
+
+  caa {
+    tag   = "issue"
+    value = "letsencrypt.org"
+  }
+}
@@ -0,0 +1,184 @@
+package vultr
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/JamesClonk/vultr/lib"
+)
+
+// catalog caches the plans and operating systems offered by Vultr for the
+// lifetime of the provider, i.e. one Terraform run. They rarely change and
+// are needed to validate the diff of every instance.
+type catalog struct {
+	mu sync.Mutex
+	// bareMetalPlans holds the bare metal plans available in each region.
+	bareMetalPlans map[int][]int
+	// operatingSystems holds all operating systems by ID.
+	operatingSystems map[int]lib.OS
+	// planDetails holds all virtual machine plans by ID.
+	planDetails map[int]lib.Plan
+	// plans holds the virtual machine plans available in each region.
+	plans map[int][]int
+}
+
+// availablePlans returns the IDs of the virtual machine plans available in the region.
+func (c *Client) availablePlans(regionID int) ([]int, error) {
+	c.catalog.mu.Lock()
+	defer c.catalog.mu.Unlock()
+
+	if plans, ok := c.catalog.plans[regionID]; ok {
+		return plans, nil
+	}
+	plans, err := c.GetAvailablePlansForRegion(regionID)
+	if err != nil {
+		return nil, err
+	}
+	if c.catalog.plans == nil {
+		c.catalog.plans = make(map[int][]int)
+	}
+	c.catalog.plans[regionID] = plans
+	return plans, nil
+}
+
+// availableBareMetalPlans returns the IDs of the bare metal plans available in the region.
+func (c *Client) availableBareMetalPlans(regionID int) ([]int, error) {
+	c.catalog.mu.Lock()
+	defer c.catalog.mu.Unlock()
+
+	if plans, ok := c.catalog.bareMetalPlans[regionID]; ok {
+		return plans, nil
+	}
+	plans, err := c.GetAvailableBareMetalPlansForRegion(regionID)
+	if err != nil {
+		return nil, err
+	}
+	if c.catalog.bareMetalPlans == nil {
+		c.catalog.bareMetalPlans = make(map[int][]int)
+	}
+	c.catalog.bareMetalPlans[regionID] = plans
+	return plans, nil
+}
+
+// planDetails returns all virtual machine plans by ID.
+func (c *Client) planDetails() (map[int]lib.Plan, error) {
+	c.catalog.mu.Lock()
+	defer c.catalog.mu.Unlock()
+
+	if c.catalog.planDetails != nil {
+		return c.catalog.planDetails, nil
+	}
+	plans, err := c.GetPlans()
+	if err != nil {
+		return nil, err
+	}
+	c.catalog.planDetails = make(map[int]lib.Plan, len(plans))
+	for _, plan := range plans {
+		c.catalog.planDetails[plan.ID] = plan
+	}
+	return c.catalog.planDetails, nil
+}
+
+// operatingSystems returns all operating systems by ID.
+func (c *Client) operatingSystems() (map[int]lib.OS, error) {
+	c.catalog.mu.Lock()
+	defer c.catalog.mu.Unlock()
+
+	if c.catalog.operatingSystems != nil {
+		return c.catalog.operatingSystems, nil
+	}
+	oses, err := c.GetOS()
+	if err != nil {
+		return nil, err
+	}
+	c.catalog.operatingSystems = make(map[int]lib.OS, len(oses))
+	for _, os := range oses {
+		c.catalog.operatingSystems[os.ID] = os
+	}
+	return c.catalog.operatingSystems, nil
+}
+
+// validatePlanAvailability ensures that the plan is available in the region.
+// If it is not, it will return an error with the list of plans available in the region.
+func validatePlanAvailability(resourceType string, planID, regionID int, list func(int) ([]int, error)) error {
+	plans, err := list(regionID)
+	if err != nil {
+		return fmt.Errorf("Error getting available %s plans for region %d: %v", resourceType, regionID, err)
+	}
+	for _, id := range plans {
+		if id == planID {
+			return nil
+		}
+	}
+	if len(plans) == 0 {
+		return fmt.Errorf("Plan %d is not available for %s in region %d: no plans are available in this region", planID, resourceType, regionID)
+	}
+	// Sort a copy, as the list may be cached.
+	ids := append([]int(nil), plans...)
+	sort.Ints(ids)
+	valid := make([]string, len(ids))
+	for i, id := range ids {
+		valid[i] = strconv.Itoa(id)
+	}
+	return fmt.Errorf("Plan %d is not available for %s in region %d. Valid plans are %s", planID, resourceType, regionID, strings.Join(valid, ", "))
+}
+
+// validateOSExists ensures that the OS exists.
+// If it does not, it will return an error with the list of valid OSs.
+func validateOSExists(osID int, list func() (map[int]lib.OS, error)) error {
+	oses, err := list()
+	if err != nil {
+		return fmt.Errorf("Error getting operating systems: %v", err)
+	}
+	if _, ok := oses[osID]; ok {
+		return nil
+	}
+	ids := make([]int, 0, len(oses))
+	for id := range oses {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	valid := make([]string, len(ids))
+	for i, id := range ids {
+		valid[i] = fmt.Sprintf("%d (%s)", id, oses[id].Name)
+	}
+	return fmt.Errorf("OS %d does not exist. Valid OSs are %s", osID, strings.Join(valid, ", "))
+}
+
+// validateOSPlan ensures that a Windows OS is only installed on a plan that supports Windows.
+// If it is not, it will return an error with the list of plans that support Windows.
+func validateOSPlan(osID, planID int, listOSs func() (map[int]lib.OS, error), listPlans func() (map[int]lib.Plan, error)) error {
+	oses, err := listOSs()
+	if err != nil {
+		return fmt.Errorf("Error getting operating systems: %v", err)
+	}
+	if !oses[osID].Windows {
+		return nil
+	}
+	plans, err := listPlans()
+	if err != nil {
+		return fmt.Errorf("Error getting plans: %v", err)
+	}
+	// Plans that do not exist are reported by validatePlanAvailability.
+	if plan, ok := plans[planID]; !ok || plan.Windows {
+		return nil
+	}
+	var ids []int
+	for id, plan := range plans {
+		if plan.Windows {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("OS %d (%s) cannot be installed on plan %d: no plans support Windows", osID, oses[osID].Name, planID)
+	}
+	sort.Ints(ids)
+	valid := make([]string, len(ids))
+	for i, id := range ids {
+		valid[i] = strconv.Itoa(id)
+	}
+	return fmt.Errorf("OS %d (%s) cannot be installed on plan %d because it does not support Windows. Valid plans are %s", osID, oses[osID].Name, planID, strings.Join(valid, ", "))
+}
@@ -0,0 +1,58 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/JamesClonk/vultr/lib"
+)
+
+func TestValidatePlanAvailability(t *testing.T) {
+	plans := []int{1000, 95, 201}
+	list := func(int) ([]int, error) { return plans, nil }
+
+	if err := validatePlanAvailability("instance", 201, 1, list); err != nil {
+		t.Errorf("expected plan 201 to be available, got %v", err)
+	}
+
+	expected := "Plan 202 is not available for instance in region 1. Valid plans are 95, 201, 1000"
+	if err := validatePlanAvailability("instance", 202, 1, list); err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+	if plans[0] != 1000 {
+		t.Errorf("expected the list of plans not to be modified, got %v", plans)
+	}
+}
+
+func TestValidateOSPlan(t *testing.T) {
+	oses := func() (map[int]lib.OS, error) {
+		return map[int]lib.OS{
+			167: {ID: 167, Name: "CentOS 7 x64"},
+			240: {ID: 240, Name: "Windows 2016 x64", Windows: true},
+		}, nil
+	}
+	plans := func() (map[int]lib.Plan, error) {
+		return map[int]lib.Plan{
+			201: {ID: 201},
+			204: {ID: 204, Windows: true},
+			203: {ID: 203, Windows: true},
+		}, nil
+	}
+
+	cases := []struct {
+		osID   int
+		planID int
+		err    string
+	}{
+		{osID: 167, planID: 201},
+		{osID: 240, planID: 203},
+		{osID: 240, planID: 999},
+		{osID: 240, planID: 201, err: "OS 240 (Windows 2016 x64) cannot be installed on plan 201 because it does not support Windows. Valid plans are 203, 204"},
+	}
+
+	for i, c := range cases {
+		err := validateOSPlan(c.osID, c.planID, oses, plans)
+		if (err == nil && c.err != "") || (err != nil && err.Error() != c.err) {
+			t.Errorf("test case %d: expected error %q, got %v", i, c.err, err)
+		}
+	}
+}
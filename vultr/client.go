@@ -0,0 +1,167 @@
+package vultr
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/JamesClonk/vultr/lib"
+)
+
+// get makes a GET request against the Vultr API for endpoints that are
+// missing or broken in JamesClonk/vultr/lib and decodes the response into data.
+func (c *Client) get(path string, values url.Values, data interface{}) error {
+	return c.do("GET", fmt.Sprintf("%s?%s", path, values.Encode()), nil, data)
+}
+
+// post makes a POST request against the Vultr API for endpoints that
+// are missing or broken in JamesClonk/vultr/lib.
+func (c *Client) post(path string, values url.Values) error {
+	return c.do("POST", path, values, nil)
+}
+
+func (c *Client) do(method, path string, values url.Values, data interface{}) error {
+	rel, err := url.Parse(fmt.Sprintf("/%s/%s", lib.APIVersion, path))
+	if err != nil {
+		return err
+	}
+
+	var reqBody io.Reader
+	if values != nil {
+		reqBody = strings.NewReader(values.Encode())
+	}
+	req, err := http.NewRequest(method, c.Endpoint.ResolveReference(rel).String(), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("API-Key", c.APIKey)
+	req.Header.Add("User-Agent", c.UserAgent)
+	req.Header.Add("Accept", "application/json")
+	if values != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	// The API returns an empty JSON array instead of an empty object when there are no results.
+	if err != nil || data == nil || string(body) == "[]" {
+		return err
+	}
+	return json.Unmarshal(body, data)
+}
+
+// EnableBackups enables automatic backups on an existing virtual machine.
+func (c *Client) EnableBackups(id string) error {
+	return c.post("server/backup_enable", url.Values{"SUBID": {id}})
+}
+
+// DisableBackups disables automatic backups on an existing virtual machine.
+func (c *Client) DisableBackups(id string) error {
+	return c.post("server/backup_disable", url.Values{"SUBID": {id}})
+}
+
+// SetBackupSchedule sets the backup schedule of a virtual machine.
+// This replaces lib.Client.BackupSetSchedule, which does not correctly
+// encode the integer fields of the schedule.
+func (c *Client) SetBackupSchedule(id string, bs lib.BackupSchedule) error {
+	values := url.Values{
+		"SUBID":     {id},
+		"cron_type": {bs.CronType},
+		"hour":      {strconv.Itoa(bs.Hour)},
+		"dow":       {strconv.Itoa(bs.Dow)},
+		"dom":       {strconv.Itoa(bs.Dom)},
+	}
+	return c.post("server/backup_set_schedule", values)
+}
+
+// SetBareMetalUserData sets the user data of a bare metal server.
+// It takes effect the next time the server is reinstalled.
+func (c *Client) SetBareMetalUserData(id, userData string) error {
+	values := url.Values{
+		"SUBID":    {id},
+		"userdata": {base64.StdEncoding.EncodeToString([]byte(userData))},
+	}
+	return c.post("baremetal/set_user_data", values)
+}
+
+// GetFirewallRulesByIPType returns the rules of a firewall group for one IP type, "v4" or "v6",
+// sorted by rule number. Unlike lib.Client.GetFirewallRules, it does not report IPv6 rules
+// that match any address as matching any IPv4 address.
+func (c *Client) GetFirewallRulesByIPType(groupID, ipType string) ([]lib.FirewallRule, error) {
+	values := url.Values{
+		"FIREWALLGROUPID": {groupID},
+		"direction":       {"in"},
+		"ip_type":         {ipType},
+	}
+	var ruleMap map[string]lib.FirewallRule
+	if err := c.get("firewall/rule_list", values, &ruleMap); err != nil {
+		return nil, err
+	}
+
+	rules := make([]lib.FirewallRule, 0, len(ruleMap))
+	for _, r := range ruleMap {
+		if ipType == "v6" && r.Network.IP.To4() != nil {
+			_, r.Network, _ = net.ParseCIDR("::/0")
+		}
+		rules = append(rules, r)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].RuleNumber < rules[j].RuleNumber
+	})
+	return rules, nil
+}
+
+// GetAvailableBareMetalPlansForRegion returns the IDs of the bare metal plans available in a region.
+// This replaces lib.Client.GetAvailableBareMetalPlansForRegion, which returns the virtual machine plans instead.
+func (c *Client) GetAvailableBareMetalPlansForRegion(id int) ([]int, error) {
+	var planIDs []int
+	if err := c.get("regions/availability_baremetal", url.Values{"DCID": {strconv.Itoa(id)}}, &planIDs); err != nil {
+		return nil, err
+	}
+	return planIDs, nil
+}
+
+// AttachBlockStorage attaches block storage to an instance. If live is true, the block storage is
+// attached without restarting the instance. This replaces lib.Client.AttachBlockStorage, which
+// always restarts the instance.
+func (c *Client) AttachBlockStorage(id, instanceID string, live bool) error {
+	values := url.Values{
+		"SUBID":           {id},
+		"attach_to_SUBID": {instanceID},
+		"live":            {yesNo(live)},
+	}
+	return c.post("block/attach", values)
+}
+
+// DetachBlockStorage detaches block storage from its instance. If live is true, the block storage is
+// detached without restarting the instance. This replaces lib.Client.DetachBlockStorage, which
+// always restarts the instance.
+func (c *Client) DetachBlockStorage(id string, live bool) error {
+	values := url.Values{
+		"SUBID": {id},
+		"live":  {yesNo(live)},
+	}
+	return c.post("block/detach", values)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
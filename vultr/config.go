@@ -1,9 +1,15 @@
 package vultr
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/JamesClonk/vultr/lib"
@@ -20,23 +26,76 @@ const logRespMsg = `Vultr API Response Details:
 %s
 -----------------------------------------------------`
 
+const redacted = "REDACTED"
+
+const (
+	// requestLoggingFull logs the headers and bodies of API requests and responses.
+	requestLoggingFull = "full"
+	// requestLoggingHeaders logs only the headers of API requests and responses.
+	requestLoggingHeaders = "headers"
+	// requestLoggingOff disables logging of API requests and responses.
+	requestLoggingOff = "off"
+)
+
+// sensitiveHeaders are the HTTP headers whose values are never logged.
+var sensitiveHeaders = []string{"API-Key", "Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveFields are the request and response body fields whose values are never logged.
+var sensitiveFields = map[string]struct{}{
+	"default_password": {},
+	"kvm_url":          {},
+	"password":         {},
+	"root_pass":        {},
+	"script":           {},
+	"ssh_key":          {},
+	"userdata":         {},
+}
+
 // Config is the configuration structure used to instantiate the Vultr
 // provider.
 type Config struct {
-	APIKey string
+	APIKey          string
+	Endpoint        string
+	MaxRetries      int
+	RateLimit       time.Duration
+	RequestLogging  string
+	RetryMaxWait    time.Duration
+	UserAgentSuffix string
 }
 
 // Client wraps a JamesClonk/vultr/lib.
 type Client struct {
 	*lib.Client
+	catalog    catalog
+	httpClient *http.Client
 }
 
 // Client configures and returns a fully initialized Vultr Client.
 func (c *Config) Client() (interface{}, error) {
-	client := Client{lib.NewClient(c.APIKey, &lib.Options{RateLimitation: 500 * time.Millisecond})}
+	userAgent := "vultr-go/" + lib.Version
+	if c.UserAgentSuffix != "" {
+		userAgent = fmt.Sprintf("%s %s", userAgent, c.UserAgentSuffix)
+	}
+
+	var onResponse lib.RequestCompletionCallback
+	if logging.IsDebugOrHigher() && c.RequestLogging != requestLoggingOff {
+		onResponse = requestLogger(c.RequestLogging == requestLoggingFull)
+	}
 
-	if logging.IsDebugOrHigher() {
-		client.OnRequestCompleted(logRequestAndResponse)
+	// Rate limiting, retries and logging are done by the HTTP client so that they apply
+	// to requests made by Client itself as well, and can be configured beyond what
+	// JamesClonk/vultr/lib supports.
+	httpClient := newHTTPClient(c.RateLimit, c.MaxRetries, c.RetryMaxWait, onResponse)
+	client := Client{
+		Client: lib.NewClient(c.APIKey, &lib.Options{
+			Endpoint:   c.Endpoint,
+			HTTPClient: httpClient,
+			// JamesClonk/vultr/lib cannot disable its own rate limiter, and a zero
+			// duration selects its default, so make it allow practically any rate.
+			RateLimitation: time.Nanosecond,
+			UserAgent:      userAgent,
+		}),
+		httpClient: httpClient,
 	}
 
 	log.Printf("[INFO] Vultr Client configured for URL: %s", client.Endpoint)
@@ -44,18 +103,131 @@ func (c *Config) Client() (interface{}, error) {
 	return &client, nil
 }
 
-func logRequestAndResponse(req *http.Request, resp *http.Response) {
-	reqData, err := httputil.DumpRequest(req, true)
+// requestLogger returns a callback that logs API requests and responses
+// with all credentials and other sensitive values redacted.
+func requestLogger(body bool) lib.RequestCompletionCallback {
+	return func(req *http.Request, resp *http.Response) {
+		logRequestAndResponse(req, resp, body)
+	}
+}
+
+func logRequestAndResponse(req *http.Request, resp *http.Response, body bool) {
+	reqData, err := dumpRequest(req, body)
 	if err == nil {
 		log.Printf("[DEBUG] "+logReqMsg, string(reqData))
 	} else {
 		log.Printf("[ERROR] Vultr API Request error: %#v", err)
 	}
 
-	respData, err := httputil.DumpResponse(resp, true)
+	respData, err := dumpResponse(resp, body)
 	if err == nil {
 		log.Printf("[DEBUG] "+logRespMsg, string(respData))
 	} else {
 		log.Printf("[ERROR] Vultr API Response error: %#v", err)
 	}
 }
+
+// dumpRequest is like httputil.DumpRequest but redacts sensitive values.
+// The body of a request has already been sent by the time it is logged,
+// so it is read from a fresh copy if one is available.
+func dumpRequest(req *http.Request, body bool) ([]byte, error) {
+	r := req.WithContext(req.Context())
+	r.Header = redactHeaders(req.Header)
+	r.Body = nil
+	data, err := httputil.DumpRequest(r, false)
+	if err != nil || !body || req.GetBody == nil {
+		return data, err
+	}
+
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		return nil, err
+	}
+	return append(data, redactBody(req.Header.Get("Content-Type"), b)...), nil
+}
+
+// dumpResponse is like httputil.DumpResponse but redacts sensitive values.
+// The response body is restored so that it can still be read by the client.
+func dumpResponse(resp *http.Response, body bool) ([]byte, error) {
+	r := *resp
+	r.Header = redactHeaders(resp.Header)
+	r.Body = nil
+	data, err := httputil.DumpResponse(&r, false)
+	if err != nil || !body || resp.Body == nil {
+		return data, err
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	return append(data, redactBody(resp.Header.Get("Content-Type"), b)...), nil
+}
+
+// redactHeaders returns a copy of the given headers with the values
+// of all sensitive headers redacted.
+func redactHeaders(h http.Header) http.Header {
+	r := make(http.Header, len(h))
+	for k, v := range h {
+		r[k] = v
+	}
+	for _, k := range sensitiveHeaders {
+		if _, ok := r[http.CanonicalHeaderKey(k)]; ok {
+			r.Set(k, redacted)
+		}
+	}
+	return r
+}
+
+// redactBody returns a copy of a form-encoded or JSON body with the values
+// of all sensitive fields redacted. Other bodies are returned unchanged.
+func redactBody(contentType string, b []byte) []byte {
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(b))
+		if err != nil {
+			return b
+		}
+		for k := range values {
+			if _, ok := sensitiveFields[k]; ok {
+				values.Set(k, redacted)
+			}
+		}
+		return []byte(values.Encode())
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return b
+	}
+	r, err := json.Marshal(redactJSON(v))
+	if err != nil {
+		return b
+	}
+	return r
+}
+
+// redactJSON recursively redacts the values of all sensitive fields in a decoded JSON value.
+func redactJSON(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k := range t {
+			if _, ok := sensitiveFields[k]; ok {
+				t[k] = redacted
+				continue
+			}
+			t[k] = redactJSON(t[k])
+		}
+	case []interface{}:
+		for i := range t {
+			t[i] = redactJSON(t[i])
+		}
+	}
+	return v
+}
@@ -0,0 +1,100 @@
+package vultr
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		contentType string
+		body        string
+		expected    string
+	}{
+		{
+			contentType: "application/x-www-form-urlencoded",
+			body:        "SUBID=123&userdata=c2VjcmV0",
+			expected:    "SUBID=123&userdata=REDACTED",
+		},
+		{
+			contentType: "application/x-www-form-urlencoded",
+			body:        "name=foo&ssh_key=ssh-rsa+AAAA",
+			expected:    "name=foo&ssh_key=REDACTED",
+		},
+		{
+			contentType: "application/json",
+			body:        `{"123":{"SUBID":"123","default_password":"hunter2"}}`,
+			expected:    `{"123":{"SUBID":"123","default_password":"REDACTED"}}`,
+		},
+		{
+			contentType: "application/json",
+			body:        `[{"root_pass":"hunter2"}]`,
+			expected:    `[{"root_pass":"REDACTED"}]`,
+		},
+		{
+			contentType: "text/plain",
+			body:        "Invalid server.",
+			expected:    "Invalid server.",
+		},
+	}
+
+	for i, c := range cases {
+		if got := string(redactBody(c.contentType, []byte(c.body))); got != c.expected {
+			t.Errorf("test case %d: expected %q, got %q", i, c.expected, got)
+		}
+	}
+}
+
+func TestDumpRequestRedactsCredentials(t *testing.T) {
+	body := url.Values{"root_pass": {"hunter2"}}.Encode()
+	req, err := http.NewRequest("POST", "https://api.vultr.com/v1/server/create", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set("API-Key", "secret-api-key")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// Drain the body as the client does when it sends the request.
+	ioutil.ReadAll(req.Body)
+
+	for _, full := range []bool{true, false} {
+		data, err := dumpRequest(req, full)
+		if err != nil {
+			t.Fatalf("failed to dump request: %v", err)
+		}
+		if strings.Contains(string(data), "secret-api-key") || strings.Contains(string(data), "hunter2") {
+			t.Errorf("expected credentials to be redacted, got %q", string(data))
+		}
+		if strings.Contains(string(data), "root_pass") != full {
+			t.Errorf("expected body to be logged: %t, got %q", full, string(data))
+		}
+	}
+	if req.Header.Get("API-Key") != "secret-api-key" {
+		t.Errorf("expected request headers to be left unchanged")
+	}
+}
+
+func TestDumpResponseRestoresBody(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "application/json")
+	rec.WriteString(`{"default_password":"hunter2"}`)
+	resp := rec.Result()
+
+	data, err := dumpResponse(resp, true)
+	if err != nil {
+		t.Fatalf("failed to dump response: %v", err)
+	}
+	if strings.Contains(string(data), "hunter2") {
+		t.Errorf("expected password to be redacted, got %q", string(data))
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response body: %v", err)
+	}
+	if string(b) != `{"default_password":"hunter2"}` {
+		t.Errorf("expected response body to be restored, got %q", string(b))
+	}
+}
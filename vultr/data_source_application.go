@@ -23,6 +23,10 @@ func dataSourceApplication() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"deploy_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -49,8 +53,8 @@ func dataSourceApplication() *schema.Resource {
 func dataSourceApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
-	filters, filtersOk := d.GetOk("filter")
-	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	_, filtersOk := d.GetOk("filter")
+	_, nameRegexOk := d.GetOk("name_regex")
 
 	if !filtersOk && !nameRegexOk {
 		return fmt.Errorf("One of %q and %q must be provided", "filter", "name_regex")
@@ -61,8 +65,41 @@ func dataSourceApplicationRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error getting applications: %v", err)
 	}
 
+	applications, err = filterApplications(d, applications)
+	if err != nil {
+		return err
+	}
+
+	sorted, err := sortResults(d, applications)
+	if err != nil {
+		return err
+	}
+
+	if len(applications) < 1 {
+		return errors.New("The query for applications returned no results. Please modify the search criteria and try again")
+	}
+
+	if len(applications) > 1 && !sorted {
+		return fmt.Errorf("The query for applications returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(applications), "sort_by")
+	}
+
+	d.SetId(applications[0].ID)
+	for k, v := range flattenApplication(applications[0]) {
+		d.Set(k, v)
+	}
+	return nil
+}
+
+// filterApplications returns the applications matching the filters and name regex of the data source, if given.
+func filterApplications(d *schema.ResourceData, applications []lib.Application) ([]lib.Application, error) {
+	filters, filtersOk := d.GetOk("filter")
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return nil, err
+		}
 		var filteredApplications []lib.Application
 		for _, application := range applications {
 			m := structToMap(application)
@@ -84,18 +121,15 @@ func dataSourceApplicationRead(d *schema.ResourceData, meta interface{}) error {
 		applications = filteredApplications
 	}
 
-	if len(applications) < 1 {
-		return errors.New("The query for applications returned no results. Please modify the search criteria and try again")
-	}
+	return applications, nil
+}
 
-	if len(applications) > 1 {
-		return fmt.Errorf("The query for applications returned %d results. Please make the search criteria more specific and try again", len(applications))
+// flattenApplication returns the attributes of an application.
+func flattenApplication(application lib.Application) map[string]interface{} {
+	return map[string]interface{}{
+		"deploy_name": application.DeployName,
+		"name":        application.Name,
+		"short_name":  application.ShortName,
+		"surcharge":   application.Surcharge,
 	}
-
-	d.SetId(applications[0].ID)
-	d.Set("deploy_name", applications[0].DeployName)
-	d.Set("name", applications[0].Name)
-	d.Set("short_name", applications[0].ShortName)
-	d.Set("surcharge", applications[0].Surcharge)
-	return nil
 }
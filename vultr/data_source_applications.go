@@ -0,0 +1,61 @@
+package vultr
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceApplications() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceApplicationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegex,
+			},
+
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
+			"applications": dataSourceListSchema(dataSourceApplication(), schema.TypeString),
+		},
+	}
+}
+
+func dataSourceApplicationsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	applications, err := client.GetApplications()
+	if err != nil {
+		return fmt.Errorf("Error getting applications: %v", err)
+	}
+
+	applications, err = filterApplications(d, applications)
+	if err != nil {
+		return err
+	}
+	if _, err := sortResults(d, applications); err != nil {
+		return err
+	}
+
+	ids := make([]string, len(applications))
+	list := make([]map[string]interface{}, len(applications))
+	for i, application := range applications {
+		ids[i] = application.ID
+		list[i] = flattenApplication(application)
+		list[i]["id"] = application.ID
+	}
+
+	d.SetId(dataSourceListID(ids))
+	if err := d.Set("applications", list); err != nil {
+		return fmt.Errorf("Error setting %q: %v", "applications", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceApplications(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccDataSourceApplicationsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vultr_applications.test", "applications.#", "1"),
+					resource.TestCheckResourceAttr("data.vultr_applications.test", "applications.0.id", "2"),
+					resource.TestCheckResourceAttr("data.vultr_applications.test", "applications.0.name", "Docker"),
+					resource.TestCheckResourceAttr("data.vultr_applications.test", "applications.0.deploy_name", "Docker on CentOS 7 x64"),
+					resource.TestCheckResourceAttr("data.vultr_applications.all", "applications.#", "2"),
+					resource.TestCheckResourceAttr("data.vultr_applications.all", "applications.0.short_name", "lemp"),
+					resource.TestCheckResourceAttr("data.vultr_applications.all", "applications.1.short_name", "docker"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceApplicationsConfig = `
+data "vultr_applications" "test" {
+	filter {
+		name   = "short_name"
+		values = ["docker"]
+	}
+}
+
+data "vultr_applications" "all" {
+	name_regex = "."
+	sort_by    = "name"
+	sort_order = "desc"
+}
+`
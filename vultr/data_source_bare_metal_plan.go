@@ -24,6 +24,10 @@ func dataSourceBareMetalPlan() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"available_locations": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -81,7 +85,10 @@ func dataSourceBareMetalPlanRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 		var filteredBareMetalPlans []lib.BareMetalPlan
 		for _, bareMetalPlan := range bareMetalPlans {
 			m := structToMap(bareMetalPlan)
@@ -103,12 +110,17 @@ func dataSourceBareMetalPlanRead(d *schema.ResourceData, meta interface{}) error
 		bareMetalPlans = filteredBareMetalPlans
 	}
 
+	sorted, err := sortResults(d, bareMetalPlans)
+	if err != nil {
+		return err
+	}
+
 	if len(bareMetalPlans) < 1 {
 		return errors.New("The query for bare metal plans returned no results. Please modify the search criteria and try again")
 	}
 
-	if len(bareMetalPlans) > 1 {
-		return fmt.Errorf("The query for bare metal plans returned %d results. Please make the search criteria more specific and try again", len(bareMetalPlans))
+	if len(bareMetalPlans) > 1 && !sorted {
+		return fmt.Errorf("The query for bare metal plans returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(bareMetalPlans), "sort_by")
 	}
 
 	d.SetId(strconv.Itoa(bareMetalPlans[0].ID))
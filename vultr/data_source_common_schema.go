@@ -1,6 +1,12 @@
 package vultr
 
-import "github.com/hashicorp/terraform/helper/schema"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
 
 func dataSourceFiltersSchema() *schema.Schema {
 	return &schema.Schema{
@@ -9,11 +15,24 @@ func dataSourceFiltersSchema() *schema.Schema {
 		ForceNew: true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
+				"ignore_case": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
 				"name": {
 					Type:     schema.TypeString,
 					Required: true,
 				},
 
+				"operator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "eq",
+					ValidateFunc: validateFilterOperator,
+				},
+
 				"values": {
 					Type:     schema.TypeList,
 					Required: true,
@@ -23,3 +42,74 @@ func dataSourceFiltersSchema() *schema.Schema {
 		},
 	}
 }
+
+// dataSourceListSchema returns the schema of a computed list of objects that
+// have an ID of the given type and all computed attributes of the given singular
+// data source, e.g. vultr_plans returns a list of vultr_plan objects. Arguments
+// that are also computed, like the tag of an instance, are included as attributes.
+func dataSourceListSchema(singular *schema.Resource, idType schema.ValueType) *schema.Schema {
+	elem := map[string]*schema.Schema{
+		"id": {
+			Type:     idType,
+			Computed: true,
+		},
+	}
+	for k, s := range singular.Schema {
+		if !s.Computed {
+			continue
+		}
+		if s.Optional {
+			s = &schema.Schema{
+				Type:     s.Type,
+				Computed: true,
+				Elem:     s.Elem,
+			}
+		}
+		elem[k] = s
+	}
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: elem,
+		},
+	}
+}
+
+// dataSourceListID returns an ID for a data source that returns a list of objects with the given IDs.
+func dataSourceListID(ids []string) string {
+	return strconv.Itoa(hashcode.String(strings.Join(ids, ",")))
+}
+
+// dataSourceSortBySchema returns the schema of the sort_by argument. Singular data sources
+// that sort their results use the first result instead of requiring exactly one match.
+func dataSourceSortBySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	}
+}
+
+// dataSourceSortOrderSchema returns the schema of the sort_order argument.
+func dataSourceSortOrderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "asc",
+		ValidateFunc: validateSortOrder,
+	}
+}
+
+// dataSourceMostRecentSchema returns the schema of the most_recent argument,
+// which sorts the results of a data source from newest to oldest.
+func dataSourceMostRecentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ForceNew:      true,
+		Default:       false,
+		ConflictsWith: []string{"sort_by"},
+	}
+}
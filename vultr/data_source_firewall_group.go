@@ -16,6 +16,8 @@ func dataSourceFirewallGroup() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
 
+			"most_recent": dataSourceMostRecentSchema(),
+
 			"description_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -23,6 +25,10 @@ func dataSourceFirewallGroup() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -62,7 +68,10 @@ func dataSourceFirewallGroupRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 		var filteredFirewallGroups []lib.FirewallGroup
 		for _, firewallGroup := range firewallGroups {
 			m := structToMap(firewallGroup)
@@ -84,12 +93,17 @@ func dataSourceFirewallGroupRead(d *schema.ResourceData, meta interface{}) error
 		firewallGroups = filteredFirewallGroups
 	}
 
+	sorted, err := sortResults(d, firewallGroups)
+	if err != nil {
+		return err
+	}
+
 	if len(firewallGroups) < 1 {
 		return errors.New("The query for firewall groups returned no results. Please modify the search criteria and try again")
 	}
 
-	if len(firewallGroups) > 1 {
-		return fmt.Errorf("The query for firewall groups returned %d results. Please make the search criteria more specific and try again", len(firewallGroups))
+	if len(firewallGroups) > 1 && !sorted {
+		return fmt.Errorf("The query for firewall groups returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(firewallGroups), "sort_by")
 	}
 
 	d.SetId(firewallGroups[0].ID)
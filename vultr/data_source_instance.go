@@ -0,0 +1,307 @@
+package vultr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/JamesClonk/vultr/lib"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceInstance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInstanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+
+			"most_recent": dataSourceMostRecentSchema(),
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegex,
+			},
+
+			"plan_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"region_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cost_per_month": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"disk": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"firewall_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ipv4_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ipv4_gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ipv4_mask": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ipv4_private_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ipv6_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"networks": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"os_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"power_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ram": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"server_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vcpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	_, filtersOk := d.GetOk("filter")
+	_, nameRegexOk := d.GetOk("name_regex")
+	_, planIDOk := d.GetOk("plan_id")
+	_, regionIDOk := d.GetOk("region_id")
+	_, tagOk := d.GetOk("tag")
+
+	if !filtersOk && !nameRegexOk && !planIDOk && !regionIDOk && !tagOk {
+		return fmt.Errorf("One of %q, %q, %q, %q and %q must be provided", "filter", "name_regex", "plan_id", "region_id", "tag")
+	}
+
+	instances, err := getInstances(d, client)
+	if err != nil {
+		return err
+	}
+
+	sorted, err := sortResults(d, instances)
+	if err != nil {
+		return err
+	}
+
+	if len(instances) < 1 {
+		return errors.New("The query for instances returned no results. Please modify the search criteria and try again")
+	}
+
+	if len(instances) > 1 && !sorted {
+		return fmt.Errorf("The query for instances returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(instances), "sort_by")
+	}
+
+	instance, err := flattenInstance(client, instances[0])
+	if err != nil {
+		return err
+	}
+
+	d.SetId(instances[0].ID)
+	for k, v := range instance {
+		d.Set(k, v)
+	}
+	return nil
+}
+
+// getInstances returns the instances matching the tag, region, plan, filters and name regex
+// of the data source, if given. Instances are listed by tag on the API side.
+func getInstances(d *schema.ResourceData, client *Client) ([]lib.Server, error) {
+	var instances []lib.Server
+	var err error
+	if tag, ok := d.GetOk("tag"); ok {
+		instances, err = client.GetServersByTag(tag.(string))
+	} else {
+		instances, err = client.GetServers()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error getting instances: %v", err)
+	}
+
+	planID, planIDOk := d.GetOk("plan_id")
+	regionID, regionIDOk := d.GetOk("region_id")
+	if planIDOk || regionIDOk {
+		var filteredInstances []lib.Server
+		for _, instance := range instances {
+			if planIDOk && instance.PlanID != planID.(int) {
+				continue
+			}
+			if regionIDOk && instance.RegionID != regionID.(int) {
+				continue
+			}
+			filteredInstances = append(filteredInstances, instance)
+		}
+		instances = filteredInstances
+	}
+
+	filters, filtersOk := d.GetOk("filter")
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
+	if filtersOk {
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return nil, err
+		}
+		var filteredInstances []lib.Server
+		for _, instance := range instances {
+			m := structToMap(instance)
+			if filter.F(m) {
+				filteredInstances = append(filteredInstances, instance)
+			}
+		}
+		instances = filteredInstances
+	}
+
+	if nameRegexOk {
+		var filteredInstances []lib.Server
+		r := regexp.MustCompile(nameRegex.(string))
+		for _, instance := range instances {
+			if r.MatchString(instance.Name) {
+				filteredInstances = append(filteredInstances, instance)
+			}
+		}
+		instances = filteredInstances
+	}
+
+	return instances, nil
+}
+
+// flattenInstance returns the attributes of an instance, including the addresses
+// of the instance in its private networks.
+func flattenInstance(client *Client, instance lib.Server) (map[string]interface{}, error) {
+	networks, err := client.ListPrivateNetworksForServer(instance.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting private networks for instance (%s): %v", instance.ID, err)
+	}
+	nets := make(map[string]string)
+	networkIDs := make([]string, 0, len(networks))
+	for _, n := range networks {
+		nets[n.ID] = n.IPAddress
+		networkIDs = append(networkIDs, n.ID)
+	}
+
+	osID, err := strconv.Atoi(instance.OSID)
+	if err != nil {
+		return nil, fmt.Errorf("OS ID must be an integer: %v", err)
+	}
+
+	ipv6s := make([]string, 0, len(instance.V6Networks))
+	for _, net := range instance.V6Networks {
+		ipv6s = append(ipv6s, net.MainIP)
+	}
+
+	return map[string]interface{}{
+		"application_id":       instance.AppID,
+		"cost_per_month":       instance.Cost,
+		"created":              instance.Created,
+		"disk":                 instance.Disk,
+		"firewall_group_id":    instance.FirewallGroupID,
+		"ipv4_address":         instance.MainIP,
+		"ipv4_gateway":         instance.GatewayV4,
+		"ipv4_mask":            instance.NetmaskV4,
+		"ipv4_private_address": instance.InternalIP,
+		"ipv6_addresses":       ipv6s,
+		"name":                 instance.Name,
+		"network_ids":          networkIDs,
+		"networks":             nets,
+		"os_id":                osID,
+		"plan_id":              instance.PlanID,
+		"power_status":         instance.PowerStatus,
+		"ram":                  instance.RAM,
+		"region_id":            instance.RegionID,
+		"server_state":         instance.ServerState,
+		"status":               instance.Status,
+		"tag":                  instance.Tag,
+		"vcpus":                instance.VCpus,
+	}, nil
+}
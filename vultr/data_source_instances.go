@@ -0,0 +1,77 @@
+package vultr
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegex,
+			},
+
+			"plan_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"region_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
+			"tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"instances": dataSourceListSchema(dataSourceInstance(), schema.TypeString),
+		},
+	}
+}
+
+func dataSourceInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	instances, err := getInstances(d, client)
+	if err != nil {
+		return err
+	}
+	if _, err := sortResults(d, instances); err != nil {
+		return err
+	}
+
+	ids := make([]string, len(instances))
+	list := make([]map[string]interface{}, len(instances))
+	for i, instance := range instances {
+		ids[i] = instance.ID
+		list[i], err = flattenInstance(client, instance)
+		if err != nil {
+			return err
+		}
+		list[i]["id"] = instance.ID
+	}
+
+	d.SetId(dataSourceListID(ids))
+	if err := d.Set("instances", list); err != nil {
+		return fmt.Errorf("Error setting %q: %v", "instances", err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceInstances(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccDataSourceInstancesConfig,
+			},
+			{
+				Config: api.providerConfig() + testAccDataSourceInstancesConfig + testAccDataSourceInstancesQueries,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vultr_instances.workers", "instances.#", "2"),
+					resource.TestCheckResourceAttr("data.vultr_instances.workers", "instances.0.name", "worker-0"),
+					resource.TestCheckResourceAttr("data.vultr_instances.workers", "instances.0.tag", "worker"),
+					resource.TestCheckResourceAttr("data.vultr_instances.workers", "instances.0.status", "active"),
+					resource.TestCheckResourceAttr("data.vultr_instances.workers", "instances.0.network_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vultr_instances.workers", "instances.0.id", "vultr_instance.worker.0", "id"),
+					resource.TestCheckResourceAttrPair("data.vultr_instances.workers", "instances.0.ipv4_address", "vultr_instance.worker.0", "ipv4_address"),
+					resource.TestCheckResourceAttrPair("data.vultr_instances.workers", "instances.0.network_ids.0", "vultr_network.test", "id"),
+					resource.TestCheckResourceAttr("data.vultr_instances.large", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vultr_instances.large", "instances.0.id", "vultr_instance.master", "id"),
+					resource.TestCheckResourceAttrPair("data.vultr_instance.master", "id", "vultr_instance.master", "id"),
+					resource.TestCheckResourceAttr("data.vultr_instance.master", "plan_id", "202"),
+					resource.TestCheckResourceAttr("data.vultr_instance.master", "region_id", "1"),
+					resource.TestCheckResourceAttr("data.vultr_instance.master", "tag", "master"),
+					resource.TestCheckResourceAttrPair("data.vultr_instance.last_worker", "id", "vultr_instance.worker.1", "id"),
+				),
+			},
+			{
+				// Remove the data sources before the instances are destroyed.
+				Config: api.providerConfig() + testAccDataSourceInstancesConfig,
+			},
+		},
+	})
+}
+
+const testAccDataSourceInstancesConfig = `
+resource "vultr_network" "test" {
+	description = "test"
+	region_id   = 1
+}
+
+resource "vultr_instance" "master" {
+	name      = "master"
+	os_id     = 167
+	plan_id   = 202
+	region_id = 1
+	tag       = "master"
+}
+
+resource "vultr_instance" "worker" {
+	count       = 2
+	name        = "worker-${count.index}"
+	network_ids = ["${vultr_network.test.id}"]
+	os_id       = 167
+	plan_id     = 201
+	region_id   = 1
+	tag         = "worker"
+}
+`
+
+const testAccDataSourceInstancesQueries = `
+data "vultr_instances" "workers" {
+	tag     = "worker"
+	sort_by = "label"
+}
+
+data "vultr_instances" "large" {
+	plan_id   = 202
+	region_id = 1
+}
+
+data "vultr_instance" "master" {
+	filter {
+		name   = "label"
+		values = ["master"]
+	}
+}
+
+data "vultr_instance" "last_worker" {
+	name_regex = "^worker-"
+	sort_by    = "label"
+	sort_order = "desc"
+}
+`
@@ -0,0 +1,116 @@
+package vultr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/JamesClonk/vultr/lib"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceISO() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceISORead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+
+			"most_recent": dataSourceMostRecentSchema(),
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegex,
+			},
+
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"filename": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"md5sum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceISORead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	filters, filtersOk := d.GetOk("filter")
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
+	if !filtersOk && !nameRegexOk {
+		return fmt.Errorf("One of %q and %q must be provided", "filter", "name_regex")
+	}
+
+	isos, err := client.GetISO()
+	if err != nil {
+		return fmt.Errorf("Error getting ISOs: %v", err)
+	}
+
+	if filtersOk {
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		var filteredISOs []lib.ISO
+		for _, iso := range isos {
+			m := structToMap(iso)
+			if filter.F(m) {
+				filteredISOs = append(filteredISOs, iso)
+			}
+		}
+		isos = filteredISOs
+	}
+
+	if nameRegexOk {
+		var filteredISOs []lib.ISO
+		r := regexp.MustCompile(nameRegex.(string))
+		for _, iso := range isos {
+			if r.MatchString(iso.Filename) {
+				filteredISOs = append(filteredISOs, iso)
+			}
+		}
+		isos = filteredISOs
+	}
+
+	sorted, err := sortResults(d, isos)
+	if err != nil {
+		return err
+	}
+
+	if len(isos) < 1 {
+		return errors.New("The query for ISOs returned no results. Please modify the search criteria and try again")
+	}
+
+	if len(isos) > 1 && !sorted {
+		return fmt.Errorf("The query for ISOs returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(isos), "sort_by")
+	}
+
+	d.SetId(strconv.Itoa(isos[0].ID))
+	d.Set("created", isos[0].Created)
+	d.Set("filename", isos[0].Filename)
+	d.Set("md5sum", isos[0].MD5sum)
+	d.Set("size", isos[0].Size)
+	return nil
+}
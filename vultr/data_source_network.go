@@ -16,6 +16,8 @@ func dataSourceNetwork() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
 
+			"most_recent": dataSourceMostRecentSchema(),
+
 			"description_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -23,6 +25,10 @@ func dataSourceNetwork() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"cidr_block": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -57,7 +63,10 @@ func dataSourceNetworkRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 		var filteredNetworks []lib.Network
 		for _, network := range networks {
 			m := structToMap(network)
@@ -79,12 +88,17 @@ func dataSourceNetworkRead(d *schema.ResourceData, meta interface{}) error {
 		networks = filteredNetworks
 	}
 
+	sorted, err := sortResults(d, networks)
+	if err != nil {
+		return err
+	}
+
 	if len(networks) < 1 {
 		return errors.New("The query for networks returned no results. Please modify the search criteria and try again")
 	}
 
-	if len(networks) > 1 {
-		return fmt.Errorf("The query for networks returned %d results. Please make the search criteria more specific and try again", len(networks))
+	if len(networks) > 1 && !sorted {
+		return fmt.Errorf("The query for networks returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(networks), "sort_by")
 	}
 
 	d.SetId(networks[0].ID)
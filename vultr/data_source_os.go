@@ -24,6 +24,10 @@ func dataSourceOS() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"arch": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,8 +59,8 @@ func dataSourceOS() *schema.Resource {
 func dataSourceOSRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
-	filters, filtersOk := d.GetOk("filter")
-	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	_, filtersOk := d.GetOk("filter")
+	_, nameRegexOk := d.GetOk("name_regex")
 
 	if !filtersOk && !nameRegexOk {
 		return fmt.Errorf("One of %q and %q must be provided", "filter", "name_regex")
@@ -67,8 +71,41 @@ func dataSourceOSRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error getting images: %v", err)
 	}
 
+	images, err = filterOSs(d, images)
+	if err != nil {
+		return err
+	}
+
+	sorted, err := sortResults(d, images)
+	if err != nil {
+		return err
+	}
+
+	if len(images) < 1 {
+		return errors.New("The query for OS returned no results. Please modify the search criteria and try again")
+	}
+
+	if len(images) > 1 && !sorted {
+		return fmt.Errorf("The query for OS returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(images), "sort_by")
+	}
+
+	d.SetId(strconv.Itoa(images[0].ID))
+	for k, v := range flattenOS(images[0]) {
+		d.Set(k, v)
+	}
+	return nil
+}
+
+// filterOSs returns the operating systems matching the filters and name regex of the data source, if given.
+func filterOSs(d *schema.ResourceData, images []lib.OS) ([]lib.OS, error) {
+	filters, filtersOk := d.GetOk("filter")
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return nil, err
+		}
 		var filteredImages []lib.OS
 		for _, image := range images {
 			m := structToMap(image)
@@ -90,19 +127,16 @@ func dataSourceOSRead(d *schema.ResourceData, meta interface{}) error {
 		images = filteredImages
 	}
 
-	if len(images) < 1 {
-		return errors.New("The query for OS returned no results. Please modify the search criteria and try again")
-	}
+	return images, nil
+}
 
-	if len(images) > 1 {
-		return fmt.Errorf("The query for OS returned %d results. Please make the search criteria more specific and try again", len(images))
+// flattenOS returns the attributes of an operating system.
+func flattenOS(image lib.OS) map[string]interface{} {
+	return map[string]interface{}{
+		"arch":      image.Arch,
+		"family":    image.Family,
+		"name":      image.Name,
+		"surcharge": image.Surcharge,
+		"windows":   image.Windows,
 	}
-
-	d.SetId(strconv.Itoa(images[0].ID))
-	d.Set("arch", images[0].Arch)
-	d.Set("family", images[0].Family)
-	d.Set("name", images[0].Name)
-	d.Set("surcharge", images[0].Surcharge)
-	d.Set("windows", images[0].Windows)
-	return nil
 }
@@ -0,0 +1,62 @@
+package vultr
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOSs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOSsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegex,
+			},
+
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
+			"oss": dataSourceListSchema(dataSourceOS(), schema.TypeInt),
+		},
+	}
+}
+
+func dataSourceOSsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	images, err := client.GetOS()
+	if err != nil {
+		return fmt.Errorf("Error getting images: %v", err)
+	}
+
+	images, err = filterOSs(d, images)
+	if err != nil {
+		return err
+	}
+	if _, err := sortResults(d, images); err != nil {
+		return err
+	}
+
+	ids := make([]string, len(images))
+	list := make([]map[string]interface{}, len(images))
+	for i, image := range images {
+		ids[i] = strconv.Itoa(image.ID)
+		list[i] = flattenOS(image)
+		list[i]["id"] = image.ID
+	}
+
+	d.SetId(dataSourceListID(ids))
+	if err := d.Set("oss", list); err != nil {
+		return fmt.Errorf("Error setting %q: %v", "oss", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceOSs(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccDataSourceOSsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vultr_oss.test", "oss.#", "2"),
+					resource.TestCheckResourceAttr("data.vultr_oss.test", "oss.0.id", "215"),
+					resource.TestCheckResourceAttr("data.vultr_oss.test", "oss.0.family", "ubuntu"),
+					resource.TestCheckResourceAttr("data.vultr_oss.test", "oss.1.id", "167"),
+					resource.TestCheckResourceAttr("data.vultr_oss.test", "oss.1.name", "CentOS 7 x64"),
+					resource.TestCheckResourceAttr("data.vultr_oss.ubuntu", "oss.#", "1"),
+					resource.TestCheckResourceAttr("data.vultr_oss.ubuntu", "oss.0.name", "Ubuntu 16.04 x64"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceOSsConfig = `
+data "vultr_oss" "test" {
+	filter {
+		name   = "family"
+		values = ["centos", "ubuntu"]
+	}
+
+	sort_by    = "name"
+	sort_order = "desc"
+}
+
+data "vultr_oss" "ubuntu" {
+	name_regex = "^Ubuntu"
+}
+`
@@ -24,6 +24,10 @@ func dataSourcePlan() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"available_locations": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -68,8 +72,8 @@ func dataSourcePlan() *schema.Resource {
 func dataSourcePlanRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
-	filters, filtersOk := d.GetOk("filter")
-	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	_, filtersOk := d.GetOk("filter")
+	_, nameRegexOk := d.GetOk("name_regex")
 
 	if !filtersOk && !nameRegexOk {
 		return fmt.Errorf("One of %q and %q must be provided", "filter", "name_regex")
@@ -80,8 +84,41 @@ func dataSourcePlanRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error getting plans: %v", err)
 	}
 
+	plans, err = filterPlans(d, plans)
+	if err != nil {
+		return err
+	}
+
+	sorted, err := sortResults(d, plans)
+	if err != nil {
+		return err
+	}
+
+	if len(plans) < 1 {
+		return errors.New("The query for plans returned no results. Please modify the search criteria and try again")
+	}
+
+	if len(plans) > 1 && !sorted {
+		return fmt.Errorf("The query for plans returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(plans), "sort_by")
+	}
+
+	d.SetId(strconv.Itoa(plans[0].ID))
+	for k, v := range flattenPlan(plans[0]) {
+		d.Set(k, v)
+	}
+	return nil
+}
+
+// filterPlans returns the plans matching the filters and name regex of the data source, if given.
+func filterPlans(d *schema.ResourceData, plans []lib.Plan) ([]lib.Plan, error) {
+	filters, filtersOk := d.GetOk("filter")
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return nil, err
+		}
 		var filteredPlans []lib.Plan
 		for _, plan := range plans {
 			m := structToMap(plan)
@@ -103,21 +140,18 @@ func dataSourcePlanRead(d *schema.ResourceData, meta interface{}) error {
 		plans = filteredPlans
 	}
 
-	if len(plans) < 1 {
-		return errors.New("The query for plans returned no results. Please modify the search criteria and try again")
-	}
+	return plans, nil
+}
 
-	if len(plans) > 1 {
-		return fmt.Errorf("The query for plans returned %d results. Please make the search criteria more specific and try again", len(plans))
+// flattenPlan returns the attributes of a plan.
+func flattenPlan(plan lib.Plan) map[string]interface{} {
+	return map[string]interface{}{
+		"available_locations": plan.Regions,
+		"bandwidth":           plan.Bandwidth,
+		"disk":                plan.Disk,
+		"name":                plan.Name,
+		"price_per_month":     plan.Price,
+		"ram":                 plan.RAM,
+		"vcpu_count":          plan.VCpus,
 	}
-
-	d.SetId(strconv.Itoa(plans[0].ID))
-	d.Set("available_locations", plans[0].Regions)
-	d.Set("bandwidth", plans[0].Bandwidth)
-	d.Set("disk", plans[0].Disk)
-	d.Set("name", plans[0].Name)
-	d.Set("price_per_month", plans[0].Price)
-	d.Set("ram", plans[0].RAM)
-	d.Set("vcpu_count", plans[0].VCpus)
-	return nil
 }
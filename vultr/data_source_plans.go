@@ -0,0 +1,62 @@
+package vultr
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourcePlans() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePlansRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegex,
+			},
+
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
+			"plans": dataSourceListSchema(dataSourcePlan(), schema.TypeInt),
+		},
+	}
+}
+
+func dataSourcePlansRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	plans, err := client.GetPlans()
+	if err != nil {
+		return fmt.Errorf("Error getting plans: %v", err)
+	}
+
+	plans, err = filterPlans(d, plans)
+	if err != nil {
+		return err
+	}
+	if _, err := sortResults(d, plans); err != nil {
+		return err
+	}
+
+	ids := make([]string, len(plans))
+	list := make([]map[string]interface{}, len(plans))
+	for i, plan := range plans {
+		ids[i] = strconv.Itoa(plan.ID)
+		list[i] = flattenPlan(plan)
+		list[i]["id"] = plan.ID
+	}
+
+	d.SetId(dataSourceListID(ids))
+	if err := d.Set("plans", list); err != nil {
+		return fmt.Errorf("Error setting %q: %v", "plans", err)
+	}
+	return nil
+}
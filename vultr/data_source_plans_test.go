@@ -0,0 +1,73 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourcePlans(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccDataSourcePlansConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vultr_plans.test", "plans.#", "2"),
+					resource.TestCheckResourceAttr("data.vultr_plans.test", "plans.0.id", "201"),
+					resource.TestCheckResourceAttr("data.vultr_plans.test", "plans.0.ram", "1024"),
+					resource.TestCheckResourceAttr("data.vultr_plans.test", "plans.1.id", "202"),
+					resource.TestCheckResourceAttr("data.vultr_plans.test", "plans.1.available_locations.#", "2"),
+					resource.TestCheckResourceAttr("data.vultr_plans.all", "plans.#", "3"),
+					resource.TestCheckResourceAttr("data.vultr_plans.all", "plans.0.id", "203"),
+					resource.TestCheckResourceAttr("data.vultr_plans.all", "plans.2.id", "201"),
+					resource.TestCheckResourceAttr("data.vultr_plan.largest", "id", "203"),
+					resource.TestCheckResourceAttr("data.vultr_plan.cheapest", "id", "202"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePlansConfig = `
+data "vultr_plans" "test" {
+	filter {
+		name   = "ram"
+		values = ["1024", "2048"]
+	}
+}
+
+data "vultr_plans" "all" {
+	sort_by    = "price_per_month"
+	sort_order = "desc"
+}
+
+data "vultr_plan" "largest" {
+	filter {
+		name   = "plan_type"
+		values = ["SSD"]
+	}
+
+	sort_by    = "ram"
+	sort_order = "desc"
+}
+
+data "vultr_plan" "cheapest" {
+	filter {
+		name     = "ram"
+		operator = "gte"
+		values   = ["2048"]
+	}
+
+	filter {
+		name     = "available_locations"
+		operator = "contains"
+		values   = ["2"]
+	}
+
+	sort_by = "price_per_month"
+}
+`
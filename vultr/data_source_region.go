@@ -24,6 +24,10 @@ func dataSourceRegion() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"block_storage": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -65,8 +69,8 @@ func dataSourceRegion() *schema.Resource {
 func dataSourceRegionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
-	filters, filtersOk := d.GetOk("filter")
-	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	_, filtersOk := d.GetOk("filter")
+	_, nameRegexOk := d.GetOk("name_regex")
 
 	if !filtersOk && !nameRegexOk {
 		return fmt.Errorf("One of %q and %q must be provided", "filter", "name_regex")
@@ -77,8 +81,41 @@ func dataSourceRegionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error getting regions: %v", err)
 	}
 
+	regions, err = filterRegions(d, regions)
+	if err != nil {
+		return err
+	}
+
+	sorted, err := sortResults(d, regions)
+	if err != nil {
+		return err
+	}
+
+	if len(regions) < 1 {
+		return errors.New("The query for regions returned no results. Please modify the search criteria and try again")
+	}
+
+	if len(regions) > 1 && !sorted {
+		return fmt.Errorf("The query for regions returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(regions), "sort_by")
+	}
+
+	d.SetId(strconv.Itoa(regions[0].ID))
+	for k, v := range flattenRegion(regions[0]) {
+		d.Set(k, v)
+	}
+	return nil
+}
+
+// filterRegions returns the regions matching the filters and name regex of the data source, if given.
+func filterRegions(d *schema.ResourceData, regions []lib.Region) ([]lib.Region, error) {
+	filters, filtersOk := d.GetOk("filter")
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return nil, err
+		}
 		var filteredRegions []lib.Region
 		for _, region := range regions {
 			m := structToMap(region)
@@ -100,21 +137,18 @@ func dataSourceRegionRead(d *schema.ResourceData, meta interface{}) error {
 		regions = filteredRegions
 	}
 
-	if len(regions) < 1 {
-		return errors.New("The query for regions returned no results. Please modify the search criteria and try again")
-	}
+	return regions, nil
+}
 
-	if len(regions) > 1 {
-		return fmt.Errorf("The query for regions returned %d results. Please make the search criteria more specific and try again", len(regions))
+// flattenRegion returns the attributes of a region.
+func flattenRegion(region lib.Region) map[string]interface{} {
+	return map[string]interface{}{
+		"block_storage":   region.BlockStorage,
+		"code":            region.Code,
+		"continent":       region.Continent,
+		"country":         region.Country,
+		"ddos_protection": region.Ddos,
+		"name":            region.Name,
+		"state":           region.State,
 	}
-
-	d.SetId(strconv.Itoa(regions[0].ID))
-	d.Set("block_storage", regions[0].BlockStorage)
-	d.Set("code", regions[0].Code)
-	d.Set("continent", regions[0].Continent)
-	d.Set("country", regions[0].Country)
-	d.Set("ddos_protection", regions[0].Ddos)
-	d.Set("name", regions[0].Name)
-	d.Set("state", regions[0].State)
-	return nil
 }
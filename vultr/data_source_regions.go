@@ -0,0 +1,62 @@
+package vultr
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceRegions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegex,
+			},
+
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
+			"regions": dataSourceListSchema(dataSourceRegion(), schema.TypeInt),
+		},
+	}
+}
+
+func dataSourceRegionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	regions, err := client.GetRegions()
+	if err != nil {
+		return fmt.Errorf("Error getting regions: %v", err)
+	}
+
+	regions, err = filterRegions(d, regions)
+	if err != nil {
+		return err
+	}
+	if _, err := sortResults(d, regions); err != nil {
+		return err
+	}
+
+	ids := make([]string, len(regions))
+	list := make([]map[string]interface{}, len(regions))
+	for i, region := range regions {
+		ids[i] = strconv.Itoa(region.ID)
+		list[i] = flattenRegion(region)
+		list[i]["id"] = region.ID
+	}
+
+	d.SetId(dataSourceListID(ids))
+	if err := d.Set("regions", list); err != nil {
+		return fmt.Errorf("Error setting %q: %v", "regions", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceRegions(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccDataSourceRegionsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vultr_regions.test", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.vultr_regions.test", "regions.0.id", "1"),
+					resource.TestCheckResourceAttr("data.vultr_regions.test", "regions.0.code", "EWR"),
+					resource.TestCheckResourceAttr("data.vultr_regions.test", "regions.0.name", "New Jersey"),
+					resource.TestCheckResourceAttr("data.vultr_regions.all", "regions.#", "2"),
+					resource.TestCheckResourceAttr("data.vultr_regions.all", "regions.0.name", "Chicago"),
+					resource.TestCheckResourceAttr("data.vultr_regions.all", "regions.1.name", "New Jersey"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRegionsConfig = `
+data "vultr_regions" "test" {
+	filter {
+		name   = "block_storage"
+		values = ["true"]
+	}
+}
+
+data "vultr_regions" "all" {
+	name_regex = "^(Chicago|New Jersey)$"
+	sort_by    = "name"
+}
+`
@@ -17,6 +17,8 @@ func dataSourceSnapshot() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
 
+			"most_recent": dataSourceMostRecentSchema(),
+
 			"description_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -24,6 +26,10 @@ func dataSourceSnapshot() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"application_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -73,7 +79,10 @@ func dataSourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 		var filteredSnapshots []lib.Snapshot
 		for _, snapshot := range snapshots {
 			m := structToMap(snapshot)
@@ -95,12 +104,17 @@ func dataSourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 		snapshots = filteredSnapshots
 	}
 
+	sorted, err := sortResults(d, snapshots)
+	if err != nil {
+		return err
+	}
+
 	if len(snapshots) < 1 {
 		return errors.New("The query for snapshots returned no results. Please modify the search criteria and try again")
 	}
 
-	if len(snapshots) > 1 {
-		return fmt.Errorf("The query for snapshots returned %d results. Please make the search criteria more specific and try again", len(snapshots))
+	if len(snapshots) > 1 && !sorted {
+		return fmt.Errorf("The query for snapshots returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(snapshots), "sort_by")
 	}
 
 	osID, err := strconv.Atoi(snapshots[0].OSID)
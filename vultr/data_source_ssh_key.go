@@ -16,6 +16,8 @@ func dataSourceSSHKey() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
 
+			"most_recent": dataSourceMostRecentSchema(),
+
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -23,6 +25,10 @@ func dataSourceSSHKey() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -52,7 +58,10 @@ func dataSourceSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 		var filteredKeys []lib.SSHKey
 		for _, key := range keys {
 			m := structToMap(key)
@@ -74,12 +83,17 @@ func dataSourceSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 		keys = filteredKeys
 	}
 
+	sorted, err := sortResults(d, keys)
+	if err != nil {
+		return err
+	}
+
 	if len(keys) < 1 {
 		return errors.New("The query for SSH keys returned no results. Please modify the search criteria and try again")
 	}
 
-	if len(keys) > 1 {
-		return fmt.Errorf("The query for SSH keys returned %d results. Please make the search criteria more specific and try again", len(keys))
+	if len(keys) > 1 && !sorted {
+		return fmt.Errorf("The query for SSH keys returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(keys), "sort_by")
 	}
 
 	d.SetId(keys[0].ID)
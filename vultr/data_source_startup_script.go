@@ -23,6 +23,10 @@ func dataSourceStartupScript() *schema.Resource {
 				ValidateFunc: validateRegex,
 			},
 
+			"sort_by": dataSourceSortBySchema(),
+
+			"sort_order": dataSourceSortOrderSchema(),
+
 			"content": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -57,7 +61,10 @@ func dataSourceStartupScriptRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if filtersOk {
-		filter := filterFromSet(filters.(*schema.Set))
+		filter, err := filterFromSet(filters.(*schema.Set))
+		if err != nil {
+			return err
+		}
 		var filteredStartupScripts []lib.StartupScript
 		for _, startupScript := range startupScripts {
 			m := structToMap(startupScript)
@@ -79,12 +86,17 @@ func dataSourceStartupScriptRead(d *schema.ResourceData, meta interface{}) error
 		startupScripts = filteredStartupScripts
 	}
 
+	sorted, err := sortResults(d, startupScripts)
+	if err != nil {
+		return err
+	}
+
 	if len(startupScripts) < 1 {
 		return errors.New("The query for startup scripts returned no results. Please modify the search criteria and try again")
 	}
 
-	if len(startupScripts) > 1 {
-		return fmt.Errorf("The query for startup scripts returned %d results. Please make the search criteria more specific and try again", len(startupScripts))
+	if len(startupScripts) > 1 && !sorted {
+		return fmt.Errorf("The query for startup scripts returned %d results. Please make the search criteria more specific or sort the results with %q and try again", len(startupScripts), "sort_by")
 	}
 
 	d.SetId(startupScripts[0].ID)
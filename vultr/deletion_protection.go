@@ -0,0 +1,71 @@
+package vultr
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// deletionProtectionSchema returns the schema of the deletion_protection argument.
+// While it is enabled, the resource can neither be destroyed nor replaced.
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// checkDeletionProtection returns an error if the resource has deletion protection enabled.
+// It is called by the Delete function of the resource, where the value is the one in the state.
+func checkDeletionProtection(d *schema.ResourceData, resourceName string) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot destroy %s (%s) because %q is enabled. Disable it in a separate apply first", resourceName, d.Id(), "deletion_protection")
+	}
+	return nil
+}
+
+// validateDeletionProtection returns an error if the diff replaces a resource that has deletion protection
+// enabled, i.e. if one of the arguments of the schema that force a new resource changes. The previous value
+// of deletion_protection is used, so it cannot be disabled in the same apply that replaces the resource.
+func validateDeletionProtection(d *schema.ResourceDiff, resourceName string, s map[string]*schema.Schema) error {
+	if !isDeletionProtected(d) {
+		return nil
+	}
+	if k, ok := forceNewChange(d, s); ok {
+		return deletionProtectionReplaceError(d, resourceName, k)
+	}
+	return nil
+}
+
+// forceNewChange returns the first argument of the schema, in alphabetical order, that forces
+// a new resource and changes in the diff. It reports whether the diff replaces an existing resource.
+func forceNewChange(d *schema.ResourceDiff, s map[string]*schema.Schema) (string, bool) {
+	if d.Id() == "" {
+		return "", false
+	}
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if s[k].ForceNew && d.HasChange(k) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// isDeletionProtected returns true if the existing resource has deletion protection enabled.
+func isDeletionProtected(d *schema.ResourceDiff) bool {
+	old, _ := d.GetChange("deletion_protection")
+	return d.Id() != "" && old.(bool)
+}
+
+// deletionProtectionReplaceError returns the error for replacing a resource with deletion protection
+// because the given argument changes.
+func deletionProtectionReplaceError(d *schema.ResourceDiff, resourceName, key string) error {
+	return fmt.Errorf("Cannot replace %s (%s) to change %q because %q is enabled. Disable it in a separate apply first", resourceName, d.Id(), key, "deletion_protection")
+}
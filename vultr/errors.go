@@ -0,0 +1,116 @@
+package vultr
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// errorKind classifies errors returned by the Vultr API so that resources
+// do not need to depend on the exact wording of error messages.
+type errorKind int
+
+const (
+	errorKindUnknown errorKind = iota
+	errorKindNotFound
+	errorKindLocked
+	errorKindRateLimited
+	errorKindPendingDestruction
+)
+
+// apiError is an unsuccessful response from the Vultr API.
+type apiError struct {
+	statusCode int
+	message    string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+// newAPIError reads and closes the body of an unsuccessful response and returns it as an *apiError.
+func newAPIError(resp *http.Response) error {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	message := strings.TrimSpace(string(body))
+	if message == "" {
+		message = resp.Status
+	}
+	return &apiError{
+		statusCode: resp.StatusCode,
+		message:    message,
+	}
+}
+
+// notFoundMessages are the prefixes of messages that the Vultr API and
+// JamesClonk/vultr/lib use to signal that a resource does not exist.
+var notFoundMessages = []string{
+	"invalid block storage",
+	"invalid domain",
+	"invalid firewall group",
+	"invalid network",
+	"invalid reserved ip",
+	"invalid script",
+	"invalid server",
+	"invalid snapshot",
+	"invalid ssh key",
+	"invalid startup script",
+	"invalid subid",
+}
+
+// classifyError returns the kind of the given error. Errors carrying an HTTP
+// status, i.e. those returned by Client.do, are classified using the status and
+// the message. JamesClonk/vultr/lib returns only the body of unsuccessful
+// responses and the status is not available to the caller, so its errors can
+// only be classified by message.
+func classifyError(err error) errorKind {
+	if err == nil {
+		return errorKindUnknown
+	}
+	var status int
+	if e, ok := err.(*apiError); ok {
+		status = e.statusCode
+	}
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return errorKindRateLimited
+	case http.StatusNotFound:
+		return errorKindNotFound
+	}
+
+	message := strings.ToLower(strings.TrimSpace(err.Error()))
+	switch {
+	case strings.Contains(message, "pending destruction"):
+		return errorKindPendingDestruction
+	case strings.Contains(message, "locked"):
+		return errorKindLocked
+	case strings.Contains(message, "rate limit"):
+		return errorKindRateLimited
+	case strings.HasSuffix(message, "not found"):
+		return errorKindNotFound
+	}
+	for _, m := range notFoundMessages {
+		if strings.HasPrefix(message, m) || strings.Contains(message, ": "+m) {
+			return errorKindNotFound
+		}
+	}
+	return errorKindUnknown
+}
+
+// isNotFoundError returns true if the error means that the requested resource does not exist.
+func isNotFoundError(err error) bool {
+	return classifyError(err) == errorKindNotFound
+}
+
+// isRetryableError returns true if the error means that the request should be retried later,
+// e.g. because the resource is locked or the API rate limit was exceeded.
+func isRetryableError(err error) bool {
+	switch classifyError(err) {
+	case errorKindLocked, errorKindPendingDestruction, errorKindRateLimited:
+		return true
+	}
+	return false
+}
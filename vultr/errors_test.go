@@ -0,0 +1,69 @@
+package vultr
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		err  error
+		kind errorKind
+	}{
+		{
+			err:  nil,
+			kind: errorKindUnknown,
+		},
+		{
+			err:  &apiError{statusCode: http.StatusPreconditionFailed, message: "Invalid server."},
+			kind: errorKindNotFound,
+		},
+		{
+			err:  errors.New("Firewall group with ID 1234abcd not found"),
+			kind: errorKindNotFound,
+		},
+		{
+			err:  errors.New("BlockStorage with ID 1234 not found"),
+			kind: errorKindNotFound,
+		},
+		{
+			err:  &apiError{statusCode: http.StatusPreconditionFailed, message: "Unable to destroy server: Unable to remove VM: Server is currently locked"},
+			kind: errorKindLocked,
+		},
+		{
+			err:  &apiError{statusCode: http.StatusPreconditionFailed, message: "Unable to destroy server: Server is already pending destruction."},
+			kind: errorKindPendingDestruction,
+		},
+		{
+			err:  &apiError{statusCode: http.StatusServiceUnavailable, message: "Rate limit reached - please try your request again later."},
+			kind: errorKindRateLimited,
+		},
+		{
+			err:  &apiError{statusCode: http.StatusPreconditionFailed, message: "Invalid network"},
+			kind: errorKindNotFound,
+		},
+		{
+			err:  errors.New("Invalid SSH key"),
+			kind: errorKindNotFound,
+		},
+		{
+			err:  errors.New("Invalid script"),
+			kind: errorKindNotFound,
+		},
+		{
+			err:  errors.New("Invalid SUBID"),
+			kind: errorKindNotFound,
+		},
+		{
+			err:  &apiError{statusCode: http.StatusInternalServerError, message: "Internal server error"},
+			kind: errorKindUnknown,
+		},
+	}
+
+	for i, c := range cases {
+		if kind := classifyError(c.err); kind != c.kind {
+			t.Errorf("test case %d: expected kind %d, got %d", i, c.kind, kind)
+		}
+	}
+}
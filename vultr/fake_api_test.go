@@ -0,0 +1,1558 @@
+package vultr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	// Resources managed by the fake API change state immediately,
+	// so there is no need to wait between polls.
+	waitDelay = 0
+	waitMinTimeout = 0
+}
+
+// fakeObject is a JSON object as returned by the Vultr API.
+type fakeObject map[string]interface{}
+
+// fakeHandler handles a request to a single API endpoint. The returned value is encoded as JSON;
+// a nil value results in an empty response body.
+type fakeHandler func(f *fakeAPI, v url.Values) (interface{}, error)
+
+// fakeDate is the creation date of every fake resource.
+const fakeDate = "2018-01-01 00:00:00"
+
+var fakeApplications = map[string]fakeObject{
+	"1": {"APPID": "1", "name": "LEMP", "short_name": "lemp", "deploy_name": "LEMP on CentOS 6 x64", "surcharge": 0},
+	"2": {"APPID": "2", "name": "Docker", "short_name": "docker", "deploy_name": "Docker on CentOS 7 x64", "surcharge": 0},
+}
+
+var fakeBareMetalPlans = map[string]fakeObject{
+	"100": {"METALPLANID": "100", "name": "32768 MB RAM,2x 240 GB SSD,5.00 TB BW", "cpu_count": 8, "ram": 32768, "disk": "2x 240 GB SSD", "bandwidth_tb": 5, "price_per_month": 120, "plan_type": "SSD", "deprecated": false, "available_locations": []int{1}},
+}
+
+var fakeISOs = map[string]fakeObject{
+	"5000": {"ISOID": 5000, "date_created": fakeDate, "filename": "installer.iso", "size": 1048576, "md5sum": "d41d8cd98f00b204e9800998ecf8427e"},
+}
+
+var fakeOperatingSystems = map[string]fakeObject{
+	"159": {"OSID": 159, "name": "Custom", "arch": "x64", "family": "iso", "windows": false},
+	"164": {"OSID": 164, "name": "Snapshot", "arch": "x64", "family": "snapshot", "windows": false},
+	"167": {"OSID": 167, "name": "CentOS 7 x64", "arch": "x64", "family": "centos", "windows": false},
+	"186": {"OSID": 186, "name": "Application", "arch": "x64", "family": "application", "windows": false},
+	"215": {"OSID": 215, "name": "Ubuntu 16.04 x64", "arch": "x64", "family": "ubuntu", "windows": false},
+	"240": {"OSID": 240, "name": "Windows 2016 x64", "arch": "x64", "family": "windows", "windows": true},
+}
+
+var fakePlans = map[string]fakeObject{
+	"201": {"VPSPLANID": "201", "name": "1024 MB RAM,25 GB SSD,1.00 TB BW", "vcpu_count": "1", "ram": "1024", "disk": "25", "bandwidth": "1.00", "price_per_month": "5.00", "plan_type": "SSD", "windows": false, "available_locations": []int{1, 2}},
+	"202": {"VPSPLANID": "202", "name": "2048 MB RAM,40 GB SSD,2.00 TB BW", "vcpu_count": "1", "ram": "2048", "disk": "40", "bandwidth": "2.00", "price_per_month": "10.00", "plan_type": "SSD", "windows": false, "available_locations": []int{1, 2}},
+	"203": {"VPSPLANID": "203", "name": "4096 MB RAM,60 GB SSD,3.00 TB BW", "vcpu_count": "2", "ram": "4096", "disk": "60", "bandwidth": "3.00", "price_per_month": "20.00", "plan_type": "SSD", "windows": true, "available_locations": []int{1}},
+}
+
+var fakeRegions = map[string]fakeObject{
+	"1": {"DCID": "1", "name": "New Jersey", "country": "US", "continent": "North America", "state": "NJ", "ddos_protection": true, "block_storage": true, "regioncode": "EWR"},
+	"2": {"DCID": "2", "name": "Chicago", "country": "US", "continent": "North America", "state": "IL", "ddos_protection": false, "block_storage": false, "regioncode": "ORD"},
+}
+
+var fakeRoutes = map[string]fakeHandler{
+	"app/list":                       func(*fakeAPI, url.Values) (interface{}, error) { return fakeApplications, nil },
+	"baremetal/app_change":           (*fakeAPI).bareMetalAppChange,
+	"baremetal/app_change_list":      (*fakeAPI).bareMetalAppChangeList,
+	"baremetal/create":               (*fakeAPI).bareMetalCreate,
+	"baremetal/destroy":              (*fakeAPI).bareMetalDestroy,
+	"baremetal/halt":                 (*fakeAPI).bareMetalNoop,
+	"baremetal/label_set":            fakeBareMetalSet("label"),
+	"baremetal/list":                 (*fakeAPI).bareMetalList,
+	"baremetal/os_change":            (*fakeAPI).bareMetalOSChange,
+	"baremetal/os_change_list":       (*fakeAPI).bareMetalOSChangeList,
+	"baremetal/reboot":               (*fakeAPI).bareMetalNoop,
+	"baremetal/reinstall":            (*fakeAPI).bareMetalNoop,
+	"baremetal/set_user_data":        fakeBareMetalSet("userdata"),
+	"baremetal/tag_set":              fakeBareMetalSet("tag"),
+	"block/attach":                   (*fakeAPI).blockAttach,
+	"block/create":                   (*fakeAPI).blockCreate,
+	"block/delete":                   (*fakeAPI).blockDelete,
+	"block/detach":                   (*fakeAPI).blockDetach,
+	"block/label_set":                (*fakeAPI).blockLabelSet,
+	"block/list":                     (*fakeAPI).blockList,
+	"block/resize":                   (*fakeAPI).blockResize,
+	"dns/create_domain":              (*fakeAPI).dnsCreateDomain,
+	"dns/create_record":              (*fakeAPI).dnsCreateRecord,
+	"dns/delete_domain":              (*fakeAPI).dnsDeleteDomain,
+	"dns/delete_record":              (*fakeAPI).dnsDeleteRecord,
+	"dns/list":                       (*fakeAPI).dnsList,
+	"dns/records":                    (*fakeAPI).dnsRecords,
+	"dns/update_record":              (*fakeAPI).dnsUpdateRecord,
+	"firewall/group_create":          (*fakeAPI).firewallGroupCreate,
+	"firewall/group_delete":          (*fakeAPI).firewallGroupDelete,
+	"firewall/group_list":            (*fakeAPI).firewallGroupList,
+	"firewall/group_set_description": (*fakeAPI).firewallGroupSetDescription,
+	"firewall/rule_create":           (*fakeAPI).firewallRuleCreate,
+	"firewall/rule_delete":           (*fakeAPI).firewallRuleDelete,
+	"firewall/rule_list":             (*fakeAPI).firewallRuleList,
+	"iso/list":                       func(*fakeAPI, url.Values) (interface{}, error) { return fakeISOs, nil },
+	"network/create":                 (*fakeAPI).networkCreate,
+	"network/destroy":                (*fakeAPI).networkDestroy,
+	"network/list":                   func(f *fakeAPI, _ url.Values) (interface{}, error) { return f.networks, nil },
+	"os/list":                        func(*fakeAPI, url.Values) (interface{}, error) { return fakeOperatingSystems, nil },
+	"plans/list":                     func(*fakeAPI, url.Values) (interface{}, error) { return fakePlans, nil },
+	"plans/list_baremetal":           func(*fakeAPI, url.Values) (interface{}, error) { return fakeBareMetalPlans, nil },
+	"regions/availability":           func(_ *fakeAPI, v url.Values) (interface{}, error) { return fakeAvailability(fakePlans, v), nil },
+	"regions/availability_baremetal": func(_ *fakeAPI, v url.Values) (interface{}, error) {
+		return fakeAvailability(fakeBareMetalPlans, v), nil
+	},
+	"regions/list":                   func(*fakeAPI, url.Values) (interface{}, error) { return fakeRegions, nil },
+	"reservedip/attach":              (*fakeAPI).reservedIPAttach,
+	"reservedip/create":              (*fakeAPI).reservedIPCreate,
+	"reservedip/destroy":             (*fakeAPI).reservedIPDestroy,
+	"reservedip/detach":              (*fakeAPI).reservedIPDetach,
+	"reservedip/list":                func(f *fakeAPI, _ url.Values) (interface{}, error) { return f.reservedIPs, nil },
+	"server/app_change":              (*fakeAPI).serverAppChange,
+	"server/app_change_list":         (*fakeAPI).serverAppChangeList,
+	"server/backup_disable":          (*fakeAPI).serverBackupDisable,
+	"server/backup_enable":           (*fakeAPI).serverBackupEnable,
+	"server/backup_get_schedule":     (*fakeAPI).serverBackupGetSchedule,
+	"server/backup_set_schedule":     (*fakeAPI).serverBackupSetSchedule,
+	"server/create":                  (*fakeAPI).serverCreate,
+	"server/create_ipv4":             (*fakeAPI).serverCreateIPv4,
+	"server/destroy":                 (*fakeAPI).serverDestroy,
+	"server/destroy_ipv4":            (*fakeAPI).serverDestroyIPv4,
+	"server/firewall_group_set":      (*fakeAPI).serverFirewallGroupSet,
+	"server/halt":                    fakeServerSet("power_status", "stopped"),
+	"server/iso_attach":              (*fakeAPI).serverISOAttach,
+	"server/iso_detach":              (*fakeAPI).serverISODetach,
+	"server/iso_status":              (*fakeAPI).serverISOStatus,
+	"server/label_set":               fakeServerSetValue("label"),
+	"server/list":                    (*fakeAPI).serverList,
+	"server/list_ipv4":               (*fakeAPI).serverListIPv4,
+	"server/os_change":               (*fakeAPI).serverOSChange,
+	"server/os_change_list":          (*fakeAPI).serverOSChangeList,
+	"server/private_network_disable": (*fakeAPI).serverPrivateNetworkDisable,
+	"server/private_network_enable":  (*fakeAPI).serverPrivateNetworkEnable,
+	"server/private_networks":        (*fakeAPI).serverPrivateNetworks,
+	"server/reboot":                  fakeServerSet("power_status", "running"),
+	"server/reinstall":               fakeServerSet("power_status", "running"),
+	"server/restore_backup":          (*fakeAPI).serverRestoreBackup,
+	"server/restore_snapshot":        (*fakeAPI).serverRestoreSnapshot,
+	"server/start":                   fakeServerSet("power_status", "running"),
+	"server/tag_set":                 fakeServerSetValue("tag"),
+	"server/upgrade_plan":            (*fakeAPI).serverUpgradePlan,
+	"server/upgrade_plan_list":       (*fakeAPI).serverUpgradePlanList,
+	"snapshot/create":                (*fakeAPI).snapshotCreate,
+	"snapshot/destroy":               (*fakeAPI).snapshotDestroy,
+	"snapshot/list":                  func(f *fakeAPI, _ url.Values) (interface{}, error) { return f.snapshots, nil },
+	"sshkey/create":                  (*fakeAPI).sshKeyCreate,
+	"sshkey/destroy":                 (*fakeAPI).sshKeyDestroy,
+	"sshkey/list":                    func(f *fakeAPI, _ url.Values) (interface{}, error) { return f.sshKeys, nil },
+	"sshkey/update":                  (*fakeAPI).sshKeyUpdate,
+	"startupscript/create":           (*fakeAPI).scriptCreate,
+	"startupscript/destroy":          (*fakeAPI).scriptDestroy,
+	"startupscript/list":             func(f *fakeAPI, _ url.Values) (interface{}, error) { return f.scripts, nil },
+	"startupscript/update":           (*fakeAPI).scriptUpdate,
+}
+
+// fakeAPI is an in-memory implementation of the parts of the Vultr v1 API that are
+// used by the provider. It allows the lifecycle of every resource to be tested
+// without network access or a Vultr account. All operations complete immediately.
+type fakeAPI struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	nextID int
+	// calls counts the requests made to each endpoint.
+	calls map[string]int
+
+	backupSchedules map[string]fakeObject
+	bareMetal       map[string]fakeObject
+	blockStorage    map[string]fakeObject
+	domains         map[string]fakeObject
+	firewallGroups  map[string]fakeObject
+	// firewallRules holds the rules of each firewall group.
+	// Like in the real API, IPv4 and IPv6 rules share one list and are numbered by their position in it.
+	firewallRules  map[string][]fakeObject
+	networks       map[string]fakeObject
+	records        map[string][]fakeObject
+	reservedIPs    map[string]fakeObject
+	scripts        map[string]fakeObject
+	servers        map[string]fakeObject
+	serverIPv4s    map[string][]fakeObject
+	serverISOs     map[string]string
+	serverNetworks map[string][]fakeObject
+	snapshots      map[string]fakeObject
+	sshKeys        map[string]fakeObject
+}
+
+// newFakeAPI starts a new fake Vultr API. It must be closed when the test is done.
+func newFakeAPI() *fakeAPI {
+	f := &fakeAPI{
+		nextID:          1000,
+		calls:           make(map[string]int),
+		backupSchedules: make(map[string]fakeObject),
+		bareMetal:       make(map[string]fakeObject),
+		blockStorage:    make(map[string]fakeObject),
+		domains:         make(map[string]fakeObject),
+		firewallGroups:  make(map[string]fakeObject),
+		firewallRules:   make(map[string][]fakeObject),
+		networks:        make(map[string]fakeObject),
+		records:         make(map[string][]fakeObject),
+		reservedIPs:     make(map[string]fakeObject),
+		scripts:         make(map[string]fakeObject),
+		servers:         make(map[string]fakeObject),
+		serverIPv4s:     make(map[string][]fakeObject),
+		serverISOs:      make(map[string]string),
+		serverNetworks:  make(map[string][]fakeObject),
+		snapshots:       make(map[string]fakeObject),
+		sshKeys:         make(map[string]fakeObject),
+	}
+	f.Server = httptest.NewServer(f)
+	return f
+}
+
+// providerConfig returns the configuration of a provider that uses the fake API.
+func (f *fakeAPI) providerConfig() string {
+	return fmt.Sprintf(`
+provider "vultr" {
+	api_key     = "fake"
+	endpoint    = "%s/"
+	max_retries = 0
+	rate_limit  = "1ms"
+}
+`, f.URL)
+}
+
+// checkDestroy returns a check that fails if any resource of the given type still exists.
+func (f *fakeAPI) checkDestroy(resourceType string, exists func(id string) bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if exists(rs.Primary.ID) {
+				return fmt.Errorf("%s (%s) still exists", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// checkCalls returns a check that fails if the number of requests made to the given endpoint differs from n.
+func (f *fakeAPI) checkCalls(path string, n int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.calls[path] != n {
+			return fmt.Errorf("expected %d requests to %s, got %d", n, path, f.calls[path])
+		}
+		return nil
+	}
+}
+
+// fakeAvailability returns the IDs of the plans available in the region given by DCID.
+func fakeAvailability(plans map[string]fakeObject, v url.Values) []int {
+	regionID, _ := strconv.Atoi(v.Get("DCID"))
+	ids := []int{}
+	for id, plan := range plans {
+		for _, r := range plan["available_locations"].([]int) {
+			if r == regionID {
+				planID, _ := strconv.Atoi(id)
+				ids = append(ids, planID)
+			}
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// in returns a function that reports whether an ID is in the given collection.
+func in(collection map[string]fakeObject) func(string) bool {
+	return func(id string) bool {
+		_, ok := collection[id]
+		return ok
+	}
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("API-Key") == "" {
+		http.Error(w, "Invalid API key", http.StatusForbidden)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	handler, ok := fakeRoutes[path]
+	if !ok {
+		// Not a 404 so that missing endpoints are not mistaken for missing resources.
+		http.Error(w, fmt.Sprintf("Endpoint %s is not implemented by the fake API", r.URL.Path), http.StatusNotImplemented)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	f.calls[path]++
+	data, err := handler(f, r.Form)
+	var body []byte
+	if err == nil && data != nil {
+		body, err = json.Marshal(data)
+	}
+	f.mu.Unlock()
+
+	if err != nil {
+		status := http.StatusInternalServerError
+		if e, ok := err.(*apiError); ok {
+			status = e.statusCode
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// invalid returns the error that the API responds with when a request is invalid.
+func invalid(format string, a ...interface{}) error {
+	return &apiError{statusCode: http.StatusPreconditionFailed, message: fmt.Sprintf(format, a...)}
+}
+
+func (f *fakeAPI) newID() string {
+	f.nextID++
+	return strconv.Itoa(f.nextID)
+}
+
+// newIPv4 returns a new address from the given /24 prefix.
+func (f *fakeAPI) newIPv4(prefix string) string {
+	f.nextID++
+	return fmt.Sprintf("%s.%d", prefix, f.nextID%253+2)
+}
+
+func newMAC(id string) string {
+	n, _ := strconv.Atoi(id)
+	return fmt.Sprintf("5a:00:00:00:%02x:%02x", (n>>8)&0xff, n&0xff)
+}
+
+// Servers
+
+func (f *fakeAPI) server(v url.Values) (fakeObject, error) {
+	server, ok := f.servers[v.Get("SUBID")]
+	if !ok {
+		return nil, invalid("Invalid server.  Check SUBID value and ensure your API key matches the server's account")
+	}
+	return server, nil
+}
+
+func (f *fakeAPI) serverList(v url.Values) (interface{}, error) {
+	if v.Get("SUBID") != "" {
+		return f.server(v)
+	}
+	servers := make(map[string]fakeObject)
+	for id, server := range f.servers {
+		if tag, ok := v["tag"]; !ok || server["tag"] == tag[0] {
+			servers[id] = server
+		}
+	}
+	return servers, nil
+}
+
+func (f *fakeAPI) serverCreate(v url.Values) (interface{}, error) {
+	region, ok := fakeRegions[v.Get("DCID")]
+	if !ok {
+		return nil, invalid("Invalid DCID")
+	}
+	plan, ok := fakePlans[v.Get("VPSPLANID")]
+	if !ok {
+		return nil, invalid("Invalid plan")
+	}
+	os, ok := fakeOperatingSystems[v.Get("OSID")]
+	if !ok {
+		return nil, invalid("Invalid operating system")
+	}
+	switch v.Get("OSID") {
+	case strconv.Itoa(osIDSnapshot):
+		if _, ok := f.snapshots[v.Get("SNAPSHOTID")]; !ok {
+			return nil, invalid("Invalid snapshot")
+		}
+	case "186":
+		if _, ok := fakeApplications[v.Get("APPID")]; !ok {
+			return nil, invalid("Invalid application")
+		}
+	}
+	if isoID := v.Get("ISOID"); isoID != "" {
+		if _, ok := fakeISOs[isoID]; !ok {
+			return nil, invalid("Invalid ISO")
+		}
+	}
+	if scriptID := v.Get("SCRIPTID"); scriptID != "" {
+		if _, ok := f.scripts[scriptID]; !ok {
+			return nil, invalid("Invalid startup script")
+		}
+	}
+	if keys := v.Get("SSHKEYID"); keys != "" {
+		for _, key := range strings.Split(keys, ",") {
+			if _, ok := f.sshKeys[key]; !ok {
+				return nil, invalid("Invalid SSH key")
+			}
+		}
+	}
+	firewallGroupID := v.Get("FIREWALLGROUPID")
+	if firewallGroupID == "" {
+		firewallGroupID = "0"
+	} else if _, ok := f.firewallGroups[firewallGroupID]; !ok {
+		return nil, invalid("Invalid firewall group")
+	}
+	for _, networkID := range v["NETWORKID[]"] {
+		if _, ok := f.networks[networkID]; !ok {
+			return nil, invalid("Invalid network")
+		}
+	}
+
+	id := f.newID()
+	ip := f.newIPv4("192.0.2")
+	server := fakeObject{
+		"SUBID":                id,
+		"label":                v.Get("label"),
+		"os":                   os["name"],
+		"ram":                  fmt.Sprintf("%v MB", plan["ram"]),
+		"disk":                 fmt.Sprintf("Virtual %v GB", plan["disk"]),
+		"main_ip":              ip,
+		"vcpu_count":           plan["vcpu_count"],
+		"location":             region["name"],
+		"DCID":                 v.Get("DCID"),
+		"default_password":     "fake-password",
+		"date_created":         fakeDate,
+		"pending_charges":      "0.00",
+		"status":               "active",
+		"cost_per_month":       plan["price_per_month"],
+		"current_bandwidth_gb": 0,
+		"allowed_bandwidth_gb": "1000",
+		"netmask_v4":           "255.255.255.0",
+		"gateway_v4":           "192.0.2.1",
+		"power_status":         "running",
+		"server_state":         "ok",
+		"VPSPLANID":            v.Get("VPSPLANID"),
+		"v6_networks":          []fakeObject{},
+		"internal_ip":          "",
+		"kvm_url":              "https://my.vultr.com/subs/vps/novnc/api.php?data=fake",
+		"auto_backups":         v.Get("auto_backups"),
+		"tag":                  v.Get("tag"),
+		"OSID":                 v.Get("OSID"),
+		"APPID":                v.Get("APPID"),
+		"FIREWALLGROUPID":      firewallGroupID,
+	}
+	f.serverIPv4s[id] = []fakeObject{{
+		"ip":          ip,
+		"netmask":     "255.255.255.0",
+		"gateway":     "192.0.2.1",
+		"mac_address": newMAC(id),
+		"type":        "main_ip",
+		"reverse":     ip + ".vultr.com",
+	}}
+	if v.Get("enable_ipv6") == "yes" {
+		server["v6_networks"] = []fakeObject{{
+			"v6_network":      fmt.Sprintf("2001:db8:%s::", id),
+			"v6_main_ip":      fmt.Sprintf("2001:db8:%s::1", id),
+			"v6_network_size": "64",
+		}}
+	}
+	if v.Get("enable_private_network") == "yes" {
+		internalIP := f.newIPv4("10.99.0")
+		server["internal_ip"] = internalIP
+		f.serverIPv4s[id] = append(f.serverIPv4s[id], fakeObject{
+			"ip":          internalIP,
+			"netmask":     "255.255.0.0",
+			"gateway":     "",
+			"mac_address": newMAC(f.newID()),
+			"type":        "private",
+			"reverse":     "",
+		})
+	}
+	for _, networkID := range v["NETWORKID[]"] {
+		f.attachNetwork(id, networkID)
+	}
+	// Like the API, only boot from the ISO when installing a custom OS.
+	if isoID := v.Get("ISOID"); isoID != "" && v.Get("OSID") == strconv.Itoa(osIDCustom) {
+		f.serverISOs[id] = isoID
+	}
+	if v.Get("auto_backups") == "yes" {
+		f.backupSchedules[id] = fakeObject{"cron_type": "daily", "hour": 0, "dow": 0, "dom": 0}
+	}
+	f.servers[id] = server
+
+	return fakeObject{"SUBID": id}, nil
+}
+
+func (f *fakeAPI) attachNetwork(id, networkID string) {
+	f.serverNetworks[id] = append(f.serverNetworks[id], fakeObject{
+		"NETWORKID":   networkID,
+		"mac_address": newMAC(f.newID()),
+		"ip_address":  f.newIPv4("10.1.0"),
+	})
+}
+
+func (f *fakeAPI) serverDestroy(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	id := v.Get("SUBID")
+	for _, storage := range f.blockStorage {
+		if storage["attached_to_SUBID"] == id {
+			storage["attached_to_SUBID"] = nil
+		}
+	}
+	for _, ip := range f.reservedIPs {
+		if ip["attached_SUBID"] == id {
+			ip["attached_SUBID"] = false
+		}
+	}
+	delete(f.backupSchedules, id)
+	delete(f.servers, id)
+	delete(f.serverIPv4s, id)
+	delete(f.serverISOs, id)
+	delete(f.serverNetworks, id)
+	return nil, nil
+}
+
+// fakeServerSet returns a handler that sets a property of a server to a fixed value.
+func fakeServerSet(key string, value interface{}) fakeHandler {
+	return func(f *fakeAPI, v url.Values) (interface{}, error) {
+		server, err := f.server(v)
+		if err != nil {
+			return nil, err
+		}
+		server[key] = value
+		return nil, nil
+	}
+}
+
+// fakeServerSetValue returns a handler that sets a property of a server to the value of the request parameter of the same name.
+func fakeServerSetValue(key string) fakeHandler {
+	return func(f *fakeAPI, v url.Values) (interface{}, error) {
+		server, err := f.server(v)
+		if err != nil {
+			return nil, err
+		}
+		server[key] = v.Get(key)
+		return nil, nil
+	}
+}
+
+func (f *fakeAPI) serverAppChange(v url.Values) (interface{}, error) {
+	server, err := f.server(v)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := fakeApplications[v.Get("APPID")]; !ok {
+		return nil, invalid("Invalid application")
+	}
+	server["APPID"] = v.Get("APPID")
+	server["OSID"] = "186"
+	server["os"] = fakeOperatingSystems["186"]["name"]
+	return nil, nil
+}
+
+func (f *fakeAPI) serverAppChangeList(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	return fakeApplications, nil
+}
+
+func (f *fakeAPI) serverOSChange(v url.Values) (interface{}, error) {
+	server, err := f.server(v)
+	if err != nil {
+		return nil, err
+	}
+	os, ok := fakeOperatingSystems[v.Get("OSID")]
+	if !ok {
+		return nil, invalid("Invalid operating system")
+	}
+	server["OSID"] = v.Get("OSID")
+	server["os"] = os["name"]
+	return nil, nil
+}
+
+func (f *fakeAPI) serverOSChangeList(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	return fakeOperatingSystems, nil
+}
+
+func (f *fakeAPI) serverUpgradePlanList(v url.Values) (interface{}, error) {
+	server, err := f.server(v)
+	if err != nil {
+		return nil, err
+	}
+	price, _ := strconv.ParseFloat(fakePlans[server["VPSPLANID"].(string)]["price_per_month"].(string), 64)
+	planIDs := []int{}
+	for id, plan := range fakePlans {
+		if p, _ := strconv.ParseFloat(plan["price_per_month"].(string), 64); p > price {
+			planID, _ := strconv.Atoi(id)
+			planIDs = append(planIDs, planID)
+		}
+	}
+	sort.Ints(planIDs)
+	return planIDs, nil
+}
+
+func (f *fakeAPI) serverUpgradePlan(v url.Values) (interface{}, error) {
+	planIDs, err := f.serverUpgradePlanList(v)
+	if err != nil {
+		return nil, err
+	}
+	planID, _ := strconv.Atoi(v.Get("VPSPLANID"))
+	for _, id := range planIDs.([]int) {
+		if id == planID {
+			plan := fakePlans[v.Get("VPSPLANID")]
+			server := f.servers[v.Get("SUBID")]
+			server["VPSPLANID"] = v.Get("VPSPLANID")
+			server["ram"] = fmt.Sprintf("%v MB", plan["ram"])
+			server["disk"] = fmt.Sprintf("Virtual %v GB", plan["disk"])
+			server["vcpu_count"] = plan["vcpu_count"]
+			server["cost_per_month"] = plan["price_per_month"]
+			return nil, nil
+		}
+	}
+	return nil, invalid("Invalid plan")
+}
+
+func (f *fakeAPI) serverFirewallGroupSet(v url.Values) (interface{}, error) {
+	server, err := f.server(v)
+	if err != nil {
+		return nil, err
+	}
+	id := v.Get("FIREWALLGROUPID")
+	if _, ok := f.firewallGroups[id]; !ok && id != "0" {
+		return nil, invalid("Invalid firewall group")
+	}
+	server["FIREWALLGROUPID"] = id
+	return nil, nil
+}
+
+func (f *fakeAPI) serverPrivateNetworks(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	networks := make(map[string]fakeObject)
+	for _, n := range f.serverNetworks[v.Get("SUBID")] {
+		networks[n["NETWORKID"].(string)] = n
+	}
+	return networks, nil
+}
+
+func (f *fakeAPI) serverPrivateNetworkEnable(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	if _, ok := f.networks[v.Get("NETWORKID")]; !ok {
+		return nil, invalid("Invalid network")
+	}
+	f.attachNetwork(v.Get("SUBID"), v.Get("NETWORKID"))
+	return nil, nil
+}
+
+func (f *fakeAPI) serverPrivateNetworkDisable(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	id := v.Get("SUBID")
+	for i, n := range f.serverNetworks[id] {
+		if n["NETWORKID"] == v.Get("NETWORKID") {
+			f.serverNetworks[id] = append(f.serverNetworks[id][:i], f.serverNetworks[id][i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, invalid("Network is not attached to this server")
+}
+
+func (f *fakeAPI) serverListIPv4(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	id := v.Get("SUBID")
+	return map[string][]fakeObject{id: f.serverIPv4s[id]}, nil
+}
+
+func (f *fakeAPI) serverCreateIPv4(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	id := v.Get("SUBID")
+	ip := f.newIPv4("192.0.2")
+	f.serverIPv4s[id] = append(f.serverIPv4s[id], fakeObject{
+		"ip":          ip,
+		"netmask":     "255.255.255.0",
+		"gateway":     "192.0.2.1",
+		"mac_address": f.serverIPv4s[id][0]["mac_address"],
+		"type":        "secondary_ip",
+		"reverse":     ip + ".vultr.com",
+	})
+	return nil, nil
+}
+
+func (f *fakeAPI) serverDestroyIPv4(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	id := v.Get("SUBID")
+	for i, ip := range f.serverIPv4s[id] {
+		if ip["ip"] != v.Get("ip") {
+			continue
+		}
+		if ip["type"] == "main_ip" {
+			return nil, invalid("Unable to remove the main IP address")
+		}
+		f.serverIPv4s[id] = append(f.serverIPv4s[id][:i], f.serverIPv4s[id][i+1:]...)
+		return nil, nil
+	}
+	return nil, invalid("Invalid IP address")
+}
+
+func (f *fakeAPI) serverISOStatus(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	if isoID, ok := f.serverISOs[v.Get("SUBID")]; ok {
+		return fakeObject{"state": "isomounted", "ISOID": isoID}, nil
+	}
+	return fakeObject{"state": "ready"}, nil
+}
+
+func (f *fakeAPI) serverISOAttach(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	if _, ok := fakeISOs[v.Get("ISOID")]; !ok {
+		return nil, invalid("Invalid ISO")
+	}
+	if _, ok := f.serverISOs[v.Get("SUBID")]; ok {
+		return nil, invalid("An ISO is already attached to this server")
+	}
+	f.serverISOs[v.Get("SUBID")] = v.Get("ISOID")
+	return nil, nil
+}
+
+func (f *fakeAPI) serverISODetach(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	if _, ok := f.serverISOs[v.Get("SUBID")]; !ok {
+		return nil, invalid("No ISO is attached to this server")
+	}
+	delete(f.serverISOs, v.Get("SUBID"))
+	return nil, nil
+}
+
+func (f *fakeAPI) serverBackupEnable(v url.Values) (interface{}, error) {
+	server, err := f.server(v)
+	if err != nil {
+		return nil, err
+	}
+	server["auto_backups"] = "yes"
+	if _, ok := f.backupSchedules[v.Get("SUBID")]; !ok {
+		f.backupSchedules[v.Get("SUBID")] = fakeObject{"cron_type": "daily", "hour": 0, "dow": 0, "dom": 0}
+	}
+	return nil, nil
+}
+
+func (f *fakeAPI) serverBackupDisable(v url.Values) (interface{}, error) {
+	server, err := f.server(v)
+	if err != nil {
+		return nil, err
+	}
+	server["auto_backups"] = "no"
+	delete(f.backupSchedules, v.Get("SUBID"))
+	return nil, nil
+}
+
+func (f *fakeAPI) serverBackupGetSchedule(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	schedule, ok := f.backupSchedules[v.Get("SUBID")]
+	if !ok {
+		return fakeObject{"enabled": false}, nil
+	}
+	return fakeObject{
+		"enabled":                 true,
+		"cron_type":               schedule["cron_type"],
+		"next_scheduled_time_utc": "2018-01-02 00:00:00",
+		"hour":                    schedule["hour"],
+		"dow":                     schedule["dow"],
+		"dom":                     schedule["dom"],
+	}, nil
+}
+
+func (f *fakeAPI) serverBackupSetSchedule(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	schedule, ok := f.backupSchedules[v.Get("SUBID")]
+	if !ok {
+		return nil, invalid("Automatic backups are not enabled for this server")
+	}
+	switch v.Get("cron_type") {
+	case "daily", "weekly", "monthly", "daily_alt_even", "daily_alt_odd":
+	default:
+		return nil, invalid("Invalid cron_type")
+	}
+	schedule["cron_type"] = v.Get("cron_type")
+	for _, key := range []string{"hour", "dow", "dom"} {
+		n, err := strconv.Atoi(v.Get(key))
+		if err != nil {
+			return nil, invalid("Invalid %s", key)
+		}
+		schedule[key] = n
+	}
+	return nil, nil
+}
+
+func (f *fakeAPI) serverRestoreBackup(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	if v.Get("BACKUPID") == "" {
+		return nil, invalid("Invalid backup")
+	}
+	return nil, nil
+}
+
+func (f *fakeAPI) serverRestoreSnapshot(v url.Values) (interface{}, error) {
+	if _, err := f.server(v); err != nil {
+		return nil, err
+	}
+	if _, ok := f.snapshots[v.Get("SNAPSHOTID")]; !ok {
+		return nil, invalid("Invalid snapshot")
+	}
+	return nil, nil
+}
+
+// Bare metal servers
+
+func (f *fakeAPI) bareMetalServer(v url.Values) (fakeObject, error) {
+	server, ok := f.bareMetal[v.Get("SUBID")]
+	if !ok {
+		return nil, invalid("Invalid server.  Check SUBID value and ensure your API key matches the server's account")
+	}
+	return server, nil
+}
+
+func (f *fakeAPI) bareMetalList(v url.Values) (interface{}, error) {
+	if v.Get("SUBID") != "" {
+		return f.bareMetalServer(v)
+	}
+	servers := make(map[string]fakeObject)
+	for id, server := range f.bareMetal {
+		if tag, ok := v["tag"]; !ok || server["tag"] == tag[0] {
+			servers[id] = server
+		}
+	}
+	return servers, nil
+}
+
+func (f *fakeAPI) bareMetalCreate(v url.Values) (interface{}, error) {
+	region, ok := fakeRegions[v.Get("DCID")]
+	if !ok {
+		return nil, invalid("Invalid DCID")
+	}
+	plan, ok := fakeBareMetalPlans[v.Get("METALPLANID")]
+	if !ok {
+		return nil, invalid("Invalid plan")
+	}
+	os, ok := fakeOperatingSystems[v.Get("OSID")]
+	if !ok {
+		return nil, invalid("Invalid operating system")
+	}
+
+	id := f.newID()
+	server := fakeObject{
+		"SUBID":            id,
+		"label":            v.Get("label"),
+		"os":               os["name"],
+		"ram":              fmt.Sprintf("%v MB", plan["ram"]),
+		"disk":             plan["disk"],
+		"main_ip":          f.newIPv4("198.51.100"),
+		"cpu_count":        plan["cpu_count"],
+		"location":         region["name"],
+		"DCID":             v.Get("DCID"),
+		"default_password": "fake-password",
+		"date_created":     fakeDate,
+		"status":           "active",
+		"netmask_v4":       "255.255.255.0",
+		"gateway_v4":       "198.51.100.1",
+		"METALPLANID":      v.Get("METALPLANID"),
+		"v6_networks":      []fakeObject{},
+		"tag":              v.Get("tag"),
+		"OSID":             v.Get("OSID"),
+		"APPID":            v.Get("APPID"),
+	}
+	if v.Get("enable_ipv6") == "yes" {
+		server["v6_networks"] = []fakeObject{{
+			"v6_network":      fmt.Sprintf("2001:db8:%s::", id),
+			"v6_main_ip":      fmt.Sprintf("2001:db8:%s::1", id),
+			"v6_network_size": "64",
+		}}
+	}
+	f.bareMetal[id] = server
+
+	return fakeObject{"SUBID": id}, nil
+}
+
+func (f *fakeAPI) bareMetalDestroy(v url.Values) (interface{}, error) {
+	if _, err := f.bareMetalServer(v); err != nil {
+		return nil, err
+	}
+	delete(f.bareMetal, v.Get("SUBID"))
+	return nil, nil
+}
+
+// bareMetalNoop handles requests that do not change the observable state of a bare metal server.
+func (f *fakeAPI) bareMetalNoop(v url.Values) (interface{}, error) {
+	_, err := f.bareMetalServer(v)
+	return nil, err
+}
+
+// fakeBareMetalSet returns a handler that sets a property of a bare metal server to the value of the request parameter of the same name.
+func fakeBareMetalSet(key string) fakeHandler {
+	return func(f *fakeAPI, v url.Values) (interface{}, error) {
+		server, err := f.bareMetalServer(v)
+		if err != nil {
+			return nil, err
+		}
+		server[key] = v.Get(key)
+		return nil, nil
+	}
+}
+
+func (f *fakeAPI) bareMetalAppChange(v url.Values) (interface{}, error) {
+	server, err := f.bareMetalServer(v)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := fakeApplications[v.Get("APPID")]; !ok {
+		return nil, invalid("Invalid application")
+	}
+	server["APPID"] = v.Get("APPID")
+	return nil, nil
+}
+
+func (f *fakeAPI) bareMetalAppChangeList(v url.Values) (interface{}, error) {
+	if _, err := f.bareMetalServer(v); err != nil {
+		return nil, err
+	}
+	return fakeApplications, nil
+}
+
+func (f *fakeAPI) bareMetalOSChange(v url.Values) (interface{}, error) {
+	server, err := f.bareMetalServer(v)
+	if err != nil {
+		return nil, err
+	}
+	os, ok := fakeOperatingSystems[v.Get("OSID")]
+	if !ok {
+		return nil, invalid("Invalid operating system")
+	}
+	server["OSID"] = v.Get("OSID")
+	server["os"] = os["name"]
+	return nil, nil
+}
+
+func (f *fakeAPI) bareMetalOSChangeList(v url.Values) (interface{}, error) {
+	if _, err := f.bareMetalServer(v); err != nil {
+		return nil, err
+	}
+	return fakeOperatingSystems, nil
+}
+
+// Block storage
+
+func (f *fakeAPI) block(v url.Values) (fakeObject, error) {
+	storage, ok := f.blockStorage[v.Get("SUBID")]
+	if !ok {
+		return nil, invalid("Invalid block storage SUBID")
+	}
+	return storage, nil
+}
+
+func (f *fakeAPI) blockList(v url.Values) (interface{}, error) {
+	storages := []fakeObject{}
+	for _, storage := range f.blockStorage {
+		storages = append(storages, storage)
+	}
+	return storages, nil
+}
+
+func (f *fakeAPI) blockCreate(v url.Values) (interface{}, error) {
+	region, ok := fakeRegions[v.Get("DCID")]
+	if !ok || region["block_storage"] != true {
+		return nil, invalid("Block storage is not available in this location")
+	}
+	size, err := strconv.Atoi(v.Get("size_gb"))
+	if err != nil || size < 10 {
+		return nil, invalid("Invalid size")
+	}
+	regionID, _ := strconv.Atoi(v.Get("DCID"))
+	id := f.newID()
+	subID, _ := strconv.Atoi(id)
+	f.blockStorage[id] = fakeObject{
+		"SUBID":             subID,
+		"date_created":      fakeDate,
+		"cost_per_month":    fmt.Sprintf("%.2f", float64(size)/10),
+		"status":            "active",
+		"size_gb":           size,
+		"DCID":              regionID,
+		"attached_to_SUBID": nil,
+		"label":             v.Get("label"),
+	}
+	return fakeObject{"SUBID": subID}, nil
+}
+
+func (f *fakeAPI) blockDelete(v url.Values) (interface{}, error) {
+	storage, err := f.block(v)
+	if err != nil {
+		return nil, err
+	}
+	if storage["attached_to_SUBID"] != nil {
+		return nil, invalid("Block storage is still attached to a server")
+	}
+	delete(f.blockStorage, v.Get("SUBID"))
+	return nil, nil
+}
+
+func (f *fakeAPI) blockResize(v url.Values) (interface{}, error) {
+	storage, err := f.block(v)
+	if err != nil {
+		return nil, err
+	}
+	size, err := strconv.Atoi(v.Get("size_gb"))
+	if err != nil || size < storage["size_gb"].(int) {
+		return nil, invalid("Block storage can only be resized to a larger size")
+	}
+	storage["size_gb"] = size
+	storage["cost_per_month"] = fmt.Sprintf("%.2f", float64(size)/10)
+	return nil, nil
+}
+
+func (f *fakeAPI) blockLabelSet(v url.Values) (interface{}, error) {
+	storage, err := f.block(v)
+	if err != nil {
+		return nil, err
+	}
+	storage["label"] = v.Get("label")
+	return nil, nil
+}
+
+func (f *fakeAPI) blockAttach(v url.Values) (interface{}, error) {
+	storage, err := f.block(v)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := f.servers[v.Get("attach_to_SUBID")]; !ok {
+		return nil, invalid("Invalid attach_to_SUBID")
+	}
+	if storage["attached_to_SUBID"] != nil {
+		return nil, invalid("Block storage is already attached to a server")
+	}
+	storage["attached_to_SUBID"] = v.Get("attach_to_SUBID")
+	return nil, nil
+}
+
+func (f *fakeAPI) blockDetach(v url.Values) (interface{}, error) {
+	storage, err := f.block(v)
+	if err != nil {
+		return nil, err
+	}
+	if storage["attached_to_SUBID"] == nil {
+		return nil, invalid("Block storage is not attached to a server")
+	}
+	storage["attached_to_SUBID"] = nil
+	return nil, nil
+}
+
+// DNS
+
+func (f *fakeAPI) dnsList(v url.Values) (interface{}, error) {
+	domains := []fakeObject{}
+	for _, domain := range f.domains {
+		domains = append(domains, domain)
+	}
+	return domains, nil
+}
+
+func (f *fakeAPI) dnsRecords(v url.Values) (interface{}, error) {
+	records, ok := f.records[v.Get("domain")]
+	if !ok {
+		return nil, invalid("Invalid domain.  Check the domain value and ensure your API key matches the domain's account")
+	}
+	return records, nil
+}
+
+func (f *fakeAPI) dnsCreateDomain(v url.Values) (interface{}, error) {
+	domain := v.Get("domain")
+	if _, ok := f.domains[domain]; ok {
+		return nil, invalid("Domain already exists")
+	}
+	f.domains[domain] = fakeObject{"domain": domain, "date_created": fakeDate}
+	f.records[domain] = []fakeObject{
+		{"RECORDID": f.nextID + 1, "type": "A", "name": "", "data": v.Get("serverip"), "priority": 0, "ttl": 300},
+		{"RECORDID": f.nextID + 2, "type": "CNAME", "name": "www", "data": domain, "priority": 0, "ttl": 300},
+	}
+	f.nextID += 2
+	return nil, nil
+}
+
+func (f *fakeAPI) dnsDeleteDomain(v url.Values) (interface{}, error) {
+	if _, ok := f.domains[v.Get("domain")]; !ok {
+		return nil, invalid("Invalid domain.  Check the domain value and ensure your API key matches the domain's account")
+	}
+	delete(f.domains, v.Get("domain"))
+	delete(f.records, v.Get("domain"))
+	return nil, nil
+}
+
+func (f *fakeAPI) dnsCreateRecord(v url.Values) (interface{}, error) {
+	domain := v.Get("domain")
+	if _, ok := f.domains[domain]; !ok {
+		return nil, invalid("Invalid domain.  Check the domain value and ensure your API key matches the domain's account")
+	}
+	priority, _ := strconv.Atoi(v.Get("priority"))
+	ttl, _ := strconv.Atoi(v.Get("ttl"))
+	if ttl == 0 {
+		ttl = 300
+	}
+	f.nextID++
+	f.records[domain] = append(f.records[domain], fakeObject{
+		"RECORDID": f.nextID,
+		"type":     v.Get("type"),
+		"name":     v.Get("name"),
+		"data":     v.Get("data"),
+		"priority": priority,
+		"ttl":      ttl,
+	})
+	return nil, nil
+}
+
+func (f *fakeAPI) dnsRecord(v url.Values) (int, error) {
+	records, ok := f.records[v.Get("domain")]
+	if !ok {
+		return 0, invalid("Invalid domain.  Check the domain value and ensure your API key matches the domain's account")
+	}
+	for i, record := range records {
+		if strconv.Itoa(record["RECORDID"].(int)) == v.Get("RECORDID") {
+			return i, nil
+		}
+	}
+	return 0, invalid("Invalid RECORDID")
+}
+
+func (f *fakeAPI) dnsUpdateRecord(v url.Values) (interface{}, error) {
+	i, err := f.dnsRecord(v)
+	if err != nil {
+		return nil, err
+	}
+	record := f.records[v.Get("domain")][i]
+	for _, key := range []string{"name", "data"} {
+		if value, ok := v[key]; ok {
+			record[key] = value[0]
+		}
+	}
+	for _, key := range []string{"priority", "ttl"} {
+		if _, ok := v[key]; ok {
+			record[key], _ = strconv.Atoi(v.Get(key))
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeAPI) dnsDeleteRecord(v url.Values) (interface{}, error) {
+	i, err := f.dnsRecord(v)
+	if err != nil {
+		return nil, err
+	}
+	domain := v.Get("domain")
+	f.records[domain] = append(f.records[domain][:i], f.records[domain][i+1:]...)
+	return nil, nil
+}
+
+// Firewalls
+
+func (f *fakeAPI) firewallGroup(v url.Values) (fakeObject, error) {
+	group, ok := f.firewallGroups[v.Get("FIREWALLGROUPID")]
+	if !ok {
+		return nil, invalid("Invalid firewall group.  Check FIREWALLGROUPID value and ensure your API key matches the group's account")
+	}
+	return group, nil
+}
+
+func (f *fakeAPI) firewallGroupList(v url.Values) (interface{}, error) {
+	groups := make(map[string]fakeObject)
+	for id, group := range f.firewallGroups {
+		if groupID, ok := v["FIREWALLGROUPID"]; ok && groupID[0] != id {
+			continue
+		}
+		var instances int
+		for _, server := range f.servers {
+			if server["FIREWALLGROUPID"] == id {
+				instances++
+			}
+		}
+		group["instance_count"] = instances
+		group["rule_count"] = len(f.firewallRules[id])
+		groups[id] = group
+	}
+	return groups, nil
+}
+
+func (f *fakeAPI) firewallGroupCreate(v url.Values) (interface{}, error) {
+	id := fmt.Sprintf("%08x", f.nextID)
+	f.nextID++
+	f.firewallGroups[id] = fakeObject{
+		"FIREWALLGROUPID": id,
+		"description":     v.Get("description"),
+		"date_created":    fakeDate,
+		"date_modified":   fakeDate,
+		"instance_count":  0,
+		"rule_count":      0,
+		"max_rule_count":  50,
+	}
+	f.firewallRules[id] = []fakeObject{}
+	return fakeObject{"FIREWALLGROUPID": id}, nil
+}
+
+func (f *fakeAPI) firewallGroupSetDescription(v url.Values) (interface{}, error) {
+	group, err := f.firewallGroup(v)
+	if err != nil {
+		return nil, err
+	}
+	group["description"] = v.Get("description")
+	return nil, nil
+}
+
+func (f *fakeAPI) firewallGroupDelete(v url.Values) (interface{}, error) {
+	if _, err := f.firewallGroup(v); err != nil {
+		return nil, err
+	}
+	id := v.Get("FIREWALLGROUPID")
+	for _, server := range f.servers {
+		if server["FIREWALLGROUPID"] == id {
+			server["FIREWALLGROUPID"] = "0"
+		}
+	}
+	delete(f.firewallGroups, id)
+	delete(f.firewallRules, id)
+	return nil, nil
+}
+
+func (f *fakeAPI) firewallRuleList(v url.Values) (interface{}, error) {
+	if _, err := f.firewallGroup(v); err != nil {
+		return nil, err
+	}
+	if v.Get("direction") != "in" {
+		return nil, invalid("Invalid direction")
+	}
+	ipType := v.Get("ip_type")
+	if ipType != "v4" && ipType != "v6" {
+		return nil, invalid("Invalid ip_type")
+	}
+	ruleMap := make(map[string]fakeObject)
+	for i, rule := range f.firewallRules[v.Get("FIREWALLGROUPID")] {
+		if rule["ip_type"] != ipType {
+			continue
+		}
+		ruleMap[strconv.Itoa(i+1)] = fakeObject{
+			"rulenumber":  i + 1,
+			"action":      rule["action"],
+			"protocol":    rule["protocol"],
+			"port":        rule["port"],
+			"subnet":      rule["subnet"],
+			"subnet_size": rule["subnet_size"],
+			"notes":       rule["notes"],
+		}
+	}
+	return ruleMap, nil
+}
+
+func (f *fakeAPI) firewallRuleCreate(v url.Values) (interface{}, error) {
+	if _, err := f.firewallGroup(v); err != nil {
+		return nil, err
+	}
+	if v.Get("direction") != "in" {
+		return nil, invalid("Invalid direction")
+	}
+	id := v.Get("FIREWALLGROUPID")
+	ipType := v.Get("ip_type")
+	if ipType != "v4" && ipType != "v6" {
+		return nil, invalid("Invalid ip_type")
+	}
+	switch v.Get("protocol") {
+	case "icmp", "tcp", "udp", "gre":
+	default:
+		return nil, invalid("Invalid protocol")
+	}
+	subnetSize, err := strconv.Atoi(v.Get("subnet_size"))
+	if err != nil {
+		return nil, invalid("Invalid subnet_size")
+	}
+	// The API reports port ranges with a dash instead of the colon used to create them.
+	port := strings.Replace(v.Get("port"), ":", " - ", 1)
+	f.firewallRules[id] = append(f.firewallRules[id], fakeObject{
+		"ip_type":     ipType,
+		"action":      "accept",
+		"protocol":    v.Get("protocol"),
+		"port":        port,
+		"subnet":      v.Get("subnet"),
+		"subnet_size": subnetSize,
+		"notes":       v.Get("notes"),
+	})
+	return fakeObject{"rulenumber": len(f.firewallRules[id])}, nil
+}
+
+func (f *fakeAPI) firewallRuleDelete(v url.Values) (interface{}, error) {
+	if _, err := f.firewallGroup(v); err != nil {
+		return nil, err
+	}
+	id := v.Get("FIREWALLGROUPID")
+	number, _ := strconv.Atoi(v.Get("rulenumber"))
+	if number < 1 || number > len(f.firewallRules[id]) {
+		return nil, invalid("Invalid rule number")
+	}
+	f.firewallRules[id] = append(f.firewallRules[id][:number-1], f.firewallRules[id][number:]...)
+	return nil, nil
+}
+
+// Networks
+
+func (f *fakeAPI) networkCreate(v url.Values) (interface{}, error) {
+	if _, ok := fakeRegions[v.Get("DCID")]; !ok {
+		return nil, invalid("Invalid DCID")
+	}
+	id := fmt.Sprintf("net%x", f.nextID)
+	f.nextID++
+	subnet, mask := v.Get("v4_subnet"), v.Get("v4_subnet_mask")
+	if subnet == "" {
+		subnet, mask = fmt.Sprintf("10.%d.96.0", f.nextID%256), "20"
+	}
+	maskBits, err := strconv.Atoi(mask)
+	if err != nil {
+		return nil, invalid("Invalid v4_subnet_mask")
+	}
+	f.networks[id] = fakeObject{
+		"NETWORKID":      id,
+		"DCID":           v.Get("DCID"),
+		"description":    v.Get("description"),
+		"v4_subnet":      subnet,
+		"v4_subnet_mask": maskBits,
+		"date_created":   fakeDate,
+	}
+	return fakeObject{"NETWORKID": id}, nil
+}
+
+func (f *fakeAPI) networkDestroy(v url.Values) (interface{}, error) {
+	id := v.Get("NETWORKID")
+	if _, ok := f.networks[id]; !ok {
+		return nil, invalid("Invalid network")
+	}
+	for _, networks := range f.serverNetworks {
+		for _, n := range networks {
+			if n["NETWORKID"] == id {
+				return nil, invalid("Network is still attached to a server")
+			}
+		}
+	}
+	delete(f.networks, id)
+	return nil, nil
+}
+
+// Reserved IPs
+
+func (f *fakeAPI) reservedIPCreate(v url.Values) (interface{}, error) {
+	if _, ok := fakeRegions[v.Get("DCID")]; !ok {
+		return nil, invalid("Invalid DCID")
+	}
+	id := f.newID()
+	subID, _ := strconv.Atoi(id)
+	ip := fakeObject{
+		"SUBID":          subID,
+		"DCID":           v.Get("DCID"),
+		"ip_type":        v.Get("ip_type"),
+		"label":          v.Get("label"),
+		"attached_SUBID": false,
+	}
+	switch v.Get("ip_type") {
+	case "v4":
+		ip["subnet"] = f.newIPv4("203.0.113")
+		ip["subnet_size"] = 32
+	case "v6":
+		ip["subnet"] = fmt.Sprintf("2001:db8:ff:%s::", id)
+		ip["subnet_size"] = 64
+	default:
+		return nil, invalid("Invalid ip_type")
+	}
+	f.reservedIPs[id] = ip
+	return fakeObject{"SUBID": subID}, nil
+}
+
+func (f *fakeAPI) reservedIPDestroy(v url.Values) (interface{}, error) {
+	if _, ok := f.reservedIPs[v.Get("SUBID")]; !ok {
+		return nil, invalid("Invalid SUBID")
+	}
+	delete(f.reservedIPs, v.Get("SUBID"))
+	return nil, nil
+}
+
+// reservedIP finds a reserved IP by its address, with or without the subnet size.
+func (f *fakeAPI) reservedIP(v url.Values) (fakeObject, error) {
+	address := strings.SplitN(v.Get("ip_address"), "/", 2)[0]
+	for _, ip := range f.reservedIPs {
+		if ip["subnet"] == address {
+			return ip, nil
+		}
+	}
+	return nil, invalid("Invalid ip_address")
+}
+
+func (f *fakeAPI) reservedIPAttach(v url.Values) (interface{}, error) {
+	ip, err := f.reservedIP(v)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := f.servers[v.Get("attach_SUBID")]; !ok {
+		return nil, invalid("Invalid attach_SUBID")
+	}
+	if ip["attached_SUBID"] != false {
+		return nil, invalid("IP address is already attached to a server")
+	}
+	ip["attached_SUBID"] = v.Get("attach_SUBID")
+	return nil, nil
+}
+
+func (f *fakeAPI) reservedIPDetach(v url.Values) (interface{}, error) {
+	ip, err := f.reservedIP(v)
+	if err != nil {
+		return nil, err
+	}
+	if ip["attached_SUBID"] != v.Get("detach_SUBID") {
+		return nil, invalid("IP address is not attached to this server")
+	}
+	ip["attached_SUBID"] = false
+	return nil, nil
+}
+
+// SSH keys
+
+func (f *fakeAPI) sshKeyCreate(v url.Values) (interface{}, error) {
+	id := fmt.Sprintf("key%x", f.nextID)
+	f.nextID++
+	f.sshKeys[id] = fakeObject{
+		"SSHKEYID":     id,
+		"name":         v.Get("name"),
+		"ssh_key":      v.Get("ssh_key"),
+		"date_created": fakeDate,
+	}
+	return fakeObject{"SSHKEYID": id}, nil
+}
+
+func (f *fakeAPI) sshKeyUpdate(v url.Values) (interface{}, error) {
+	key, ok := f.sshKeys[v.Get("SSHKEYID")]
+	if !ok {
+		return nil, invalid("Invalid SSH key")
+	}
+	for _, param := range []string{"name", "ssh_key"} {
+		if value, ok := v[param]; ok {
+			key[param] = value[0]
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeAPI) sshKeyDestroy(v url.Values) (interface{}, error) {
+	if _, ok := f.sshKeys[v.Get("SSHKEYID")]; !ok {
+		return nil, invalid("Invalid SSH key")
+	}
+	delete(f.sshKeys, v.Get("SSHKEYID"))
+	return nil, nil
+}
+
+// Startup scripts
+
+func (f *fakeAPI) scriptCreate(v url.Values) (interface{}, error) {
+	scriptType := v.Get("type")
+	if scriptType == "" {
+		scriptType = "boot"
+	}
+	if scriptType != "boot" && scriptType != "pxe" {
+		return nil, invalid("Invalid script type")
+	}
+	id := f.newID()
+	f.scripts[id] = fakeObject{
+		"SCRIPTID":      id,
+		"date_created":  fakeDate,
+		"date_modified": fakeDate,
+		"name":          v.Get("name"),
+		"type":          scriptType,
+		"script":        v.Get("script"),
+	}
+	return fakeObject{"SCRIPTID": id}, nil
+}
+
+func (f *fakeAPI) scriptUpdate(v url.Values) (interface{}, error) {
+	script, ok := f.scripts[v.Get("SCRIPTID")]
+	if !ok {
+		return nil, invalid("Invalid script")
+	}
+	for _, param := range []string{"name", "script"} {
+		if value, ok := v[param]; ok {
+			script[param] = value[0]
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeAPI) scriptDestroy(v url.Values) (interface{}, error) {
+	if _, ok := f.scripts[v.Get("SCRIPTID")]; !ok {
+		return nil, invalid("Invalid script")
+	}
+	delete(f.scripts, v.Get("SCRIPTID"))
+	return nil, nil
+}
+
+// Snapshots
+
+func (f *fakeAPI) snapshotCreate(v url.Values) (interface{}, error) {
+	server, err := f.server(v)
+	if err != nil {
+		return nil, err
+	}
+	id := fmt.Sprintf("snap%x", f.nextID)
+	f.nextID++
+	f.snapshots[id] = fakeObject{
+		"SNAPSHOTID":   id,
+		"date_created": fakeDate,
+		"description":  v.Get("description"),
+		"size":         "26843545600",
+		"status":       "complete",
+		"OSID":         server["OSID"],
+		"APPID":        server["APPID"],
+	}
+	return fakeObject{"SNAPSHOTID": id}, nil
+}
+
+func (f *fakeAPI) snapshotDestroy(v url.Values) (interface{}, error) {
+	if _, ok := f.snapshots[v.Get("SNAPSHOTID")]; !ok {
+		return nil, invalid("Invalid snapshot ID")
+	}
+	delete(f.snapshots, v.Get("SNAPSHOTID"))
+	return nil, nil
+}
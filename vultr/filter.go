@@ -1,58 +1,157 @@
 package vultr
 
 import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/squat/terraform-provider-vultr/structs"
 )
 
-func structToMap(s interface{}) map[string]string {
+// fieldValue is the string form of a struct field. Lists have one value per element.
+type fieldValue struct {
+	values []string
+	list   bool
+}
+
+// structToMap returns the string form of every exported field of a struct by its JSON name.
+// Fields of nested structs and maps are flattened into keys of the form <field>.<nested-field>.
+func structToMap(s interface{}) map[string]fieldValue {
 	st := structs.New(s)
 	st.TagName = "json"
-	m := st.Map()
-	m2 := make(map[string]string)
-	for k, v := range m {
-		switch v.(type) {
-		case string:
-			m2[k] = v.(string)
-		case bool:
-			m2[k] = strconv.FormatBool(v.(bool))
-		case int:
-			m2[k] = strconv.FormatInt(int64(v.(int)), 10)
+	m := make(map[string]fieldValue)
+	for k, v := range st.Map() {
+		flattenField(m, k, v, false)
+	}
+	return m
+}
+
+func flattenField(m map[string]fieldValue, key string, v interface{}, list bool) {
+	if v == nil {
+		return
+	}
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, v := range t {
+			flattenField(m, key+"."+k, v, list)
+		}
+		return
+	case string:
+		appendField(m, key, t, list)
+		return
+	case bool:
+		appendField(m, key, strconv.FormatBool(t), list)
+		return
+	case float32:
+		appendField(m, key, strconv.FormatFloat(float64(t), 'f', -1, 32), list)
+		return
+	case float64:
+		appendField(m, key, strconv.FormatFloat(t, 'f', -1, 64), list)
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if _, ok := m[key]; !ok {
+			m[key] = fieldValue{list: true}
+		}
+		for i := 0; i < rv.Len(); i++ {
+			flattenField(m, key, rv.Index(i).Interface(), true)
+		}
+	case reflect.Map:
+		for _, k := range rv.MapKeys() {
+			flattenField(m, fmt.Sprintf("%s.%v", key, k.Interface()), rv.MapIndex(k).Interface(), list)
 		}
+	default:
+		appendField(m, key, fmt.Sprintf("%v", v), list)
 	}
-	return m2
+}
+
+func appendField(m map[string]fieldValue, key, value string, list bool) {
+	f := m[key]
+	f.values = append(f.values, value)
+	f.list = f.list || list
+	m[key] = f
 }
 
 // Filter is a simple interface to describe type that accepts a
-// map[string]string and returns a boolean saying whether or not it
+// map of struct fields and returns a boolean saying whether or not it
 // matches the filter.
 type Filter interface {
-	F(map[string]string) bool
+	F(map[string]fieldValue) bool
 }
 
+// filterOperators are the operators that can be used to compare a field with the values of a filter.
+var filterOperators = []string{"contains", "eq", "gt", "gte", "lt", "lte", "regex"}
+
 type filter struct {
-	name   string
-	values []string
+	name       string
+	values     []string
+	operator   string
+	ignoreCase bool
+	// numbers holds the values of numeric comparisons.
+	numbers []float64
+	// regexes holds the values of regex filters.
+	regexes []*regexp.Regexp
 }
 
-func (f *filter) F(m map[string]string) bool {
-	v, ok := m[f.name]
+// F returns true if any value of the field matches any value of the filter.
+func (f *filter) F(m map[string]fieldValue) bool {
+	field, ok := m[f.name]
 	if !ok {
 		return false
 	}
-	for _, value := range f.values {
-		if v == value {
-			return true
+	for _, v := range field.values {
+		for i := range f.values {
+			if f.match(v, i, field.list) {
+				return true
+			}
 		}
 	}
 	return false
 }
 
+// match returns true if a single value of a field matches the ith value of the filter.
+func (f *filter) match(v string, i int, list bool) bool {
+	switch f.operator {
+	case "regex":
+		return f.regexes[i].MatchString(v)
+	case "gt", "gte", "lt", "lte":
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return false
+		}
+		switch f.operator {
+		case "gt":
+			return n > f.numbers[i]
+		case "gte":
+			return n >= f.numbers[i]
+		case "lt":
+			return n < f.numbers[i]
+		default:
+			return n <= f.numbers[i]
+		}
+	}
+
+	value := f.values[i]
+	if f.ignoreCase {
+		v, value = strings.ToLower(v), strings.ToLower(value)
+	}
+	// Lists contain a value if one of their elements equals it; strings if it is a substring.
+	if f.operator == "contains" && !list {
+		return strings.Contains(v, value)
+	}
+	return v == value
+}
+
 type multiFilter []filter
 
-func (f multiFilter) F(m map[string]string) bool {
+func (f multiFilter) F(m map[string]fieldValue) bool {
 	for _, filter := range f {
 		if !filter.F(m) {
 			return false
@@ -61,18 +160,102 @@ func (f multiFilter) F(m map[string]string) bool {
 	return true
 }
 
-func filterFromSet(set *schema.Set) Filter {
+func filterFromSet(set *schema.Set) (Filter, error) {
 	var filters []filter
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
-		var values []string
+		f := filter{
+			name:       m["name"].(string),
+			operator:   m["operator"].(string),
+			ignoreCase: m["ignore_case"].(bool),
+		}
 		for _, value := range m["values"].([]interface{}) {
-			values = append(values, value.(string))
+			f.values = append(f.values, value.(string))
+		}
+
+		switch f.operator {
+		case "regex":
+			for _, value := range f.values {
+				if f.ignoreCase {
+					value = "(?i)" + value
+				}
+				r, err := regexp.Compile(value)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing regular expression of filter %q: %v", f.name, err)
+				}
+				f.regexes = append(f.regexes, r)
+			}
+		case "gt", "gte", "lt", "lte":
+			for _, value := range f.values {
+				n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+				if err != nil {
+					return nil, fmt.Errorf("Filter %q with operator %q requires numeric values, got %q", f.name, f.operator, value)
+				}
+				f.numbers = append(f.numbers, n)
+			}
+		}
+
+		filters = append(filters, f)
+	}
+	return multiFilter(filters), nil
+}
+
+// sortResults sorts a slice of results by the field given by sort_by in the order given
+// by sort_order, or from newest to oldest if most_recent is set. It reports whether
+// the results were sorted, in which case a singular data source uses the first result.
+func sortResults(d *schema.ResourceData, results interface{}) (bool, error) {
+	field := d.Get("sort_by").(string)
+	desc := d.Get("sort_order").(string) == "desc"
+	if mostRecent, ok := d.Get("most_recent").(bool); ok && mostRecent {
+		field, desc = "date_created", true
+	}
+	if field == "" {
+		return false, nil
+	}
+
+	v := reflect.ValueOf(results)
+	s := &resultSorter{
+		desc:   desc,
+		values: make([]string, v.Len()),
+		swap:   reflect.Swapper(results),
+	}
+	for i := range s.values {
+		f, ok := structToMap(v.Index(i).Interface())[field]
+		if !ok || f.list || len(f.values) != 1 {
+			return false, fmt.Errorf("Cannot sort by %q: the results do not have such a field or it is a list", field)
 		}
-		filters = append(filters, filter{
-			name:   m["name"].(string),
-			values: values,
-		})
+		s.values[i] = f.values[0]
+	}
+	sort.Stable(s)
+	return true, nil
+}
+
+// resultSorter sorts results by one of their fields. Values that are
+// both numbers are compared numerically and other values lexically.
+type resultSorter struct {
+	desc   bool
+	values []string
+	swap   func(i, j int)
+}
+
+func (s *resultSorter) Len() int {
+	return len(s.values)
+}
+
+func (s *resultSorter) Less(i, j int) bool {
+	if s.desc {
+		i, j = j, i
 	}
-	return multiFilter(filters)
+	a, b := s.values[i], s.values[j]
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return fa < fb
+	}
+	return a < b
+}
+
+func (s *resultSorter) Swap(i, j int) {
+	s.swap(i, j)
+	s.values[i], s.values[j] = s.values[j], s.values[i]
 }
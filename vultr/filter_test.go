@@ -0,0 +1,98 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/JamesClonk/vultr/lib"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestFilterFromSet(t *testing.T) {
+	plan := lib.Plan{
+		ID:       201,
+		Name:     "1024 MB RAM,25 GB SSD,1.00 TB BW",
+		VCpus:    1,
+		RAM:      "1024",
+		Price:    "5.00",
+		PlanType: "SSD",
+		Regions:  []int{1, 12},
+	}
+
+	cases := []struct {
+		filter map[string]interface{}
+		match  bool
+		err    bool
+	}{
+		{
+			filter: map[string]interface{}{"name": "VPSPLANID", "values": []interface{}{"201"}},
+			match:  true,
+		},
+		{
+			filter: map[string]interface{}{"name": "plan_type", "values": []interface{}{"ssd"}},
+			match:  false,
+		},
+		{
+			filter: map[string]interface{}{"name": "plan_type", "values": []interface{}{"ssd"}, "ignore_case": true},
+			match:  true,
+		},
+		{
+			filter: map[string]interface{}{"name": "name", "values": []interface{}{`^\d+ MB RAM`}, "operator": "regex"},
+			match:  true,
+		},
+		{
+			filter: map[string]interface{}{"name": "name", "values": []interface{}{"("}, "operator": "regex"},
+			err:    true,
+		},
+		{
+			filter: map[string]interface{}{"name": "ram", "values": []interface{}{"1024"}, "operator": "gte"},
+			match:  true,
+		},
+		{
+			filter: map[string]interface{}{"name": "ram", "values": []interface{}{"1024"}, "operator": "gt"},
+			match:  false,
+		},
+		{
+			filter: map[string]interface{}{"name": "price_per_month", "values": []interface{}{"10"}, "operator": "lt"},
+			match:  true,
+		},
+		{
+			filter: map[string]interface{}{"name": "price_per_month", "values": []interface{}{"cheap"}, "operator": "lte"},
+			err:    true,
+		},
+		{
+			filter: map[string]interface{}{"name": "available_locations", "values": []interface{}{"12"}, "operator": "contains"},
+			match:  true,
+		},
+		{
+			filter: map[string]interface{}{"name": "available_locations", "values": []interface{}{"2"}, "operator": "contains"},
+			match:  false,
+		},
+		{
+			filter: map[string]interface{}{"name": "name", "values": []interface{}{"25 GB"}, "operator": "contains"},
+			match:  true,
+		},
+		{
+			filter: map[string]interface{}{"name": "missing", "values": []interface{}{""}},
+			match:  false,
+		},
+	}
+
+	for i, c := range cases {
+		m := map[string]interface{}{"operator": "eq", "ignore_case": false}
+		for k, v := range c.filter {
+			m[k] = v
+		}
+		set := schema.NewSet(schema.HashResource(dataSourceFiltersSchema().Elem.(*schema.Resource)), []interface{}{m})
+		filter, err := filterFromSet(set)
+		if (err != nil) != c.err {
+			t.Errorf("test case %d: expected error %t, got %v", i, c.err, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if match := filter.F(structToMap(plan)); match != c.match {
+			t.Errorf("test case %d: expected match %t, got %t", i, c.match, match)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package vultr
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/JamesClonk/vultr/lib"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -16,34 +20,90 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("VULTR_API_KEY", nil),
 				Description: "The key for API operations. You can retrieve this from the 'API' tab of the 'Account' section  of the Vultr console.",
 			},
+
+			"endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("VULTR_ENDPOINT", lib.DefaultEndpoint),
+				ValidateFunc: validateURL,
+				Description:  "The URL of the Vultr API. This can be used to point the provider at a mock API for testing.",
+			},
+
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VULTR_MAX_RETRIES", 3),
+				Description: "The maximum number of times to retry an API request that failed because of rate limiting or a transient server error. Requests that change resources are only retried if the API rejected them because of rate limiting or unavailability.",
+			},
+
+			"rate_limit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("VULTR_RATE_LIMIT", "500ms"),
+				ValidateFunc: validateDuration,
+				Description:  "The minimum duration between API requests, e.g. '500ms'.",
+			},
+
+			"request_logging": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("VULTR_REQUEST_LOGGING", requestLoggingFull),
+				ValidateFunc: validateRequestLogging,
+				Description:  "How much of each API request and response to write to the debug log: 'full', 'headers', or 'off'. Credentials and other sensitive values are always redacted.",
+			},
+
+			"retry_max_wait": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("VULTR_RETRY_MAX_WAIT", "30s"),
+				ValidateFunc: validateDuration,
+				Description:  "The maximum duration to wait between retries of a failed API request, e.g. '30s'.",
+			},
+
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VULTR_USER_AGENT_SUFFIX", ""),
+				Description: "A string to append to the User-Agent header of API requests.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"vultr_application":     dataSourceApplication(),
+			"vultr_applications":    dataSourceApplications(),
 			"vultr_bare_metal_plan": dataSourceBareMetalPlan(),
 			"vultr_firewall_group":  dataSourceFirewallGroup(),
+			"vultr_instance":        dataSourceInstance(),
+			"vultr_instances":       dataSourceInstances(),
+			"vultr_iso":             dataSourceISO(),
 			"vultr_network":         dataSourceNetwork(),
 			"vultr_os":              dataSourceOS(),
+			"vultr_oss":             dataSourceOSs(),
 			"vultr_plan":            dataSourcePlan(),
+			"vultr_plans":           dataSourcePlans(),
 			"vultr_region":          dataSourceRegion(),
+			"vultr_regions":         dataSourceRegions(),
 			"vultr_snapshot":        dataSourceSnapshot(),
 			"vultr_ssh_key":         dataSourceSSHKey(),
 			"vultr_startup_script":  dataSourceStartupScript(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"vultr_bare_metal":     resourceBareMetal(),
-			"vultr_block_storage":  resourceBlockStorage(),
-			"vultr_dns_domain":     resourceDNSDomain(),
-			"vultr_dns_record":     resourceDNSRecord(),
-			"vultr_firewall_group": resourceFirewallGroup(),
-			"vultr_firewall_rule":  resourceFirewallRule(),
-			"vultr_instance":       resourceInstance(),
-			"vultr_ipv4":           resourceIPV4(),
-			"vultr_network":        resourceNetwork(),
-			"vultr_reserved_ip":    resourceReservedIP(),
-			"vultr_ssh_key":        resourceSSHKey(),
-			"vultr_startup_script": resourceStartupScript(),
+			"vultr_bare_metal":               resourceBareMetal(),
+			"vultr_block_storage":            resourceBlockStorage(),
+			"vultr_block_storage_attachment": resourceBlockStorageAttachment(),
+			"vultr_dns_domain":               resourceDNSDomain(),
+			"vultr_dns_record":               resourceDNSRecord(),
+			"vultr_firewall_group":           resourceFirewallGroup(),
+			"vultr_firewall_rule":            resourceFirewallRule(),
+			"vultr_firewall_rules":           resourceFirewallRules(),
+			"vultr_instance":                 resourceInstance(),
+			"vultr_ipv4":                     resourceIPV4(),
+			"vultr_network":                  resourceNetwork(),
+			"vultr_reserved_ip":              resourceReservedIP(),
+			"vultr_snapshot":                 resourceSnapshot(),
+			"vultr_ssh_key":                  resourceSSHKey(),
+			"vultr_startup_script":           resourceStartupScript(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -51,8 +111,23 @@ func Provider() terraform.ResourceProvider {
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	rateLimit, err := time.ParseDuration(d.Get("rate_limit").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q: %v", "rate_limit", err)
+	}
+	retryMaxWait, err := time.ParseDuration(d.Get("retry_max_wait").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q: %v", "retry_max_wait", err)
+	}
+
 	config := Config{
-		APIKey: d.Get("api_key").(string),
+		APIKey:          d.Get("api_key").(string),
+		Endpoint:        d.Get("endpoint").(string),
+		MaxRetries:      d.Get("max_retries").(int),
+		RateLimit:       rateLimit,
+		RequestLogging:  d.Get("request_logging").(string),
+		RetryMaxWait:    retryMaxWait,
+		UserAgentSuffix: d.Get("user_agent_suffix").(string),
 	}
 	return config.Client()
 }
@@ -5,6 +5,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/JamesClonk/vultr/lib"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,12 @@ func resourceBareMetal() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceBareMetalCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"application_id": {
@@ -38,11 +45,15 @@ func resourceBareMetal() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"deletion_protection": deletionProtectionSchema(),
+
 			"disk": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			// The hostname, snapshot, SSH keys and startup script are only used when the instance is created.
+			// The Vultr API cannot change them later, not even by reinstalling, so changing them requires a new instance.
 			"hostname": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -89,17 +100,38 @@ func resourceBareMetal() *schema.Resource {
 				ForceNew: true,
 			},
 
+			// The API does not report the power status of bare metal instances, so power_state is
+			// not read back and changes made outside of Terraform are not detected.
+			"power_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validatePowerState,
+			},
+
 			"ram": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			// Changing reboot_trigger or reinstall_trigger reboots or reinstalls the instance. The API
+			// reports no state that changes while bare metal instances reboot or reinstall, so the
+			// apply does not wait for the instance to be running again.
+			"reboot_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"region_id": {
 				Type:     schema.TypeInt,
 				Required: true,
 				ForceNew: true,
 			},
 
+			"reinstall_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"snapshot_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -128,6 +160,13 @@ func resourceBareMetal() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			// User data is only applied when the instance is installed. Changing it does not
+			// reinstall the instance, as that erases its disks; change reinstall_trigger to apply it.
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -154,6 +193,7 @@ func resourceBareMetalCreate(d *schema.ResourceData, meta interface{}) error {
 		Script:               d.Get("startup_script_id").(int),
 		Snapshot:             d.Get("snapshot_id").(string),
 		Tag:                  d.Get("tag").(string),
+		UserData:             d.Get("user_data").(string),
 	}
 
 	name := d.Get("name").(string)
@@ -179,9 +219,17 @@ func resourceBareMetalCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(instance.ID)
 
-	if _, err := waitForResourceState(d, meta, "bare metal instance", "status", resourceBareMetalRead, "active", []string{"pending"}); err != nil {
+	if _, err := waitForResourceState(d, meta, schema.TimeoutCreate, "bare metal instance", "status", resourceBareMetalRead, "active", []string{"pending"}); err != nil {
 		return err
 	}
+
+	// Bare metal instances are always started after they are created, so halt them if they should be stopped.
+	if d.Get("power_state").(string) == "stopped" {
+		if err := setBareMetalPowerState(d, meta, "stopped"); err != nil {
+			return err
+		}
+	}
+
 	return resourceBareMetalRead(d, meta)
 }
 
@@ -190,7 +238,7 @@ func resourceBareMetalRead(d *schema.ResourceData, meta interface{}) error {
 
 	instance, err := client.GetBareMetalServer(d.Id())
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "Invalid server") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing bare metal instance (%s) because it is gone", d.Id())
 			d.Set("status", "none")
 			d.SetId("")
@@ -245,7 +293,7 @@ func resourceBareMetalUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err := changeApplication(d.Id(), "bare metal instance", new.(string), client.ChangeApplicationofBareMetalServer, client.ListApplicationsforBareMetalServer); err != nil {
 			return err
 		}
-		if _, err := waitForResourceState(d, meta, "bare metal instance", "application_id", resourceBareMetalRead, new.(string), []string{"", old.(string)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "bare metal instance", "application_id", resourceBareMetalRead, new.(string), []string{"", old.(string)}); err != nil {
 			return err
 		}
 		d.SetPartial("application_id")
@@ -257,7 +305,7 @@ func resourceBareMetalUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err := client.RenameBareMetalServer(d.Id(), new.(string)); err != nil {
 			return fmt.Errorf("Error renaming bare metal instance (%s) to %q: %v", d.Id(), new.(string), err)
 		}
-		if _, err := waitForResourceState(d, meta, "bare metal instance", "name", resourceBareMetalRead, new.(string), []string{"", old.(string)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "bare metal instance", "name", resourceBareMetalRead, new.(string), []string{"", old.(string)}); err != nil {
 			return err
 		}
 		d.SetPartial("name")
@@ -269,19 +317,61 @@ func resourceBareMetalUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err := changeOS(d.Id(), "bare metal instance", new.(int), client.ChangeOSofBareMetalServer, client.ListOSforBareMetalServer); err != nil {
 			return err
 		}
-		if _, err := waitForResourceState(d, meta, "bare metal instance", "os_id", resourceBareMetalRead, strconv.FormatInt(int64(new.(int)), 10), []string{"", strconv.FormatInt(int64(old.(int)), 10)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "bare metal instance", "os_id", resourceBareMetalRead, strconv.FormatInt(int64(new.(int)), 10), []string{"", strconv.FormatInt(int64(old.(int)), 10)}); err != nil {
 			return err
 		}
 		d.SetPartial("os_id")
 	}
 
+	// Reinstalling and rebooting both leave the instance running.
+	var restarted bool
+
+	if d.HasChange("user_data") {
+		log.Printf("[INFO] Updating bare metal instance (%s) user data", d.Id())
+		if err := client.SetBareMetalUserData(d.Id(), d.Get("user_data").(string)); err != nil {
+			return fmt.Errorf("Error setting user data of bare metal instance (%s): %v", d.Id(), err)
+		}
+	}
+
+	if hasTriggerChange(d, "reinstall_trigger") {
+		log.Printf("[INFO] Reinstalling bare metal instance (%s)", d.Id())
+		if err := client.ReinstallBareMetalServer(d.Id()); err != nil {
+			return fmt.Errorf("Error reinstalling bare metal instance (%s): %v", d.Id(), err)
+		}
+		restarted = true
+	}
+	d.SetPartial("reinstall_trigger")
+	d.SetPartial("user_data")
+
+	if hasTriggerChange(d, "reboot_trigger") && !restarted {
+		log.Printf("[INFO] Rebooting bare metal instance (%s)", d.Id())
+		if err := setBareMetalPowerState(d, meta, "running"); err != nil {
+			return err
+		}
+		restarted = true
+	}
+	d.SetPartial("reboot_trigger")
+
+	if d.HasChange("power_state") || restarted {
+		old, new := d.GetChange("power_state")
+		// Bare metal instances are running unless they were explicitly halted.
+		running := restarted || old.(string) != "stopped"
+		if (new.(string) == "stopped" && running) || (new.(string) == "running" && !running) {
+			log.Printf("[INFO] Updating bare metal instance (%s) power state", d.Id())
+			if err := setBareMetalPowerState(d, meta, new.(string)); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("power_state")
+	}
+
 	if d.HasChange("tag") {
 		log.Printf("[INFO] Updating bare metal instance (%s) tag", d.Id())
 		old, new := d.GetChange("tag")
 		if err := client.TagBareMetalServer(d.Id(), new.(string)); err != nil {
 			return fmt.Errorf("Error tagging bare metal instance (%s) with %q: %v", d.Id(), new.(string), err)
 		}
-		if _, err := waitForResourceState(d, meta, "bare metal instance", "tag", resourceBareMetalRead, new.(string), []string{"", old.(string)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "bare metal instance", "tag", resourceBareMetalRead, new.(string), []string{"", old.(string)}); err != nil {
 			return err
 		}
 		d.SetPartial("tag")
@@ -292,17 +382,66 @@ func resourceBareMetalUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceBareMetalRead(d, meta)
 }
 
+func resourceBareMetalCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateDeletionProtection(d, "bare metal instance", resourceBareMetal().Schema); err != nil {
+		return err
+	}
+	if (d.HasChange("plan_id") || d.HasChange("region_id")) && d.NewValueKnown("plan_id") && d.NewValueKnown("region_id") {
+		if err := validatePlanAvailability("bare metal instance", d.Get("plan_id").(int), d.Get("region_id").(int), meta.(*Client).availableBareMetalPlans); err != nil {
+			return err
+		}
+	}
+	if osID, ok := d.GetOk("os_id"); ok && d.HasChange("os_id") && d.NewValueKnown("os_id") {
+		if err := validateOSExists(osID.(int), meta.(*Client).operatingSystems); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasTriggerChange returns true if the value of a trigger argument was changed.
+// Setting or removing a trigger does not count as a change, so that adding a
+// trigger to an existing resource does not reinstall or reboot it.
+func hasTriggerChange(d *schema.ResourceData, key string) bool {
+	old, new := d.GetChange(key)
+	return old.(string) != "" && new.(string) != "" && old.(string) != new.(string)
+}
+
+// setBareMetalPowerState starts or halts a bare metal instance.
+// The Vultr API does not offer a start endpoint, so halted instances are started by rebooting them.
+// It does not report the power status of bare metal instances either, and their status stays
+// "active" throughout, so there is nothing to wait for once the request has been accepted.
+func setBareMetalPowerState(d *schema.ResourceData, meta interface{}, state string) error {
+	client := meta.(*Client)
+
+	switch state {
+	case "running":
+		if err := client.RebootBareMetalServer(d.Id()); err != nil {
+			return fmt.Errorf("Error starting bare metal instance (%s): %v", d.Id(), err)
+		}
+	case "stopped":
+		if err := client.HaltBareMetalServer(d.Id()); err != nil {
+			return fmt.Errorf("Error halting bare metal instance (%s): %v", d.Id(), err)
+		}
+	}
+	return nil
+}
+
 func resourceBareMetalDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
+	if err := checkDeletionProtection(d, "bare metal instance"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Destroying bare metal instance (%s)", d.Id())
 
-	if err := client.DeleteBareMetalServer(d.Id()); err != nil {
+	if err := client.DeleteBareMetalServer(d.Id()); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying bare metal instance (%s): %v", d.Id(), err)
 	}
 
 	// Wait for the instance to be fully destroyed.
-	if _, err := waitForResourceState(d, meta, "bare metal instance", "status", resourceBareMetalRead, "none", []string{"pending"}); err != nil {
+	if _, err := waitForResourceState(d, meta, schema.TimeoutDelete, "bare metal instance", "status", resourceBareMetalRead, "none", []string{"pending"}); err != nil {
 		return fmt.Errorf("Error waiting for bare metal instance (%s) to be destroyed: %v", d.Id(), err)
 	}
 
@@ -0,0 +1,107 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceBareMetal(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_bare_metal", in(api.bareMetal)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceBareMetalConfig("test", "web", "", "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "cpus", "8"),
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "name", "test"),
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "os_id", "167"),
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "plan_id", "100"),
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "region_id", "1"),
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "status", "active"),
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "tag", "web"),
+					resource.TestCheckResourceAttrSet("vultr_bare_metal.test", "ipv4_address"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBareMetalConfig("renamed", "db", "", "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "tag", "db"),
+				),
+			},
+			{
+				// Setting the triggers for the first time must not reinstall or reboot the instance.
+				Config: api.providerConfig() + testAccResourceBareMetalConfig("renamed", "db", "", "1", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					api.checkCalls("baremetal/reboot", 0),
+					api.checkCalls("baremetal/reinstall", 0),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBareMetalConfig("renamed", "db", "", "2", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					api.checkCalls("baremetal/reboot", 1),
+					api.checkCalls("baremetal/reinstall", 0),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBareMetalConfig("renamed", "db", "", "3", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "status", "active"),
+					api.checkCalls("baremetal/reboot", 1),
+					api.checkCalls("baremetal/reinstall", 1),
+				),
+			},
+			{
+				// Changing the user data must not reinstall the instance and erase its disks.
+				Config: api.providerConfig() + testAccResourceBareMetalConfig("renamed", "db", "#cloud-config", "3", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_bare_metal.test", "user_data", "#cloud-config"),
+					api.checkCalls("baremetal/set_user_data", 1),
+					api.checkCalls("baremetal/reinstall", 1),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBareMetalConfig("renamed", "db", "#cloud-config", "3", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					api.checkCalls("baremetal/set_user_data", 1),
+					api.checkCalls("baremetal/reinstall", 2),
+				),
+			},
+			{
+				Config:                  api.providerConfig() + testAccResourceBareMetalConfig("renamed", "db", "#cloud-config", "3", "3"),
+				ResourceName:            "vultr_bare_metal.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "reboot_trigger", "reinstall_trigger", "user_data"},
+			},
+		},
+	})
+}
+
+func testAccResourceBareMetalConfig(name, tag, userData, rebootTrigger, reinstallTrigger string) string {
+	return fmt.Sprintf(`
+resource "vultr_bare_metal" "test" {
+	name              = "%s"
+	os_id             = 167
+	plan_id           = 100
+	reboot_trigger    = "%s"
+	region_id         = 1
+	reinstall_trigger = "%s"
+	tag               = "%s"
+	user_data         = "%s"
+
+	timeouts {
+		create = "5m"
+		update = "5m"
+		delete = "5m"
+	}
+}
+`, name, rebootTrigger, reinstallTrigger, tag, userData)
+}
@@ -3,7 +3,8 @@ package vultr
 import (
 	"fmt"
 	"log"
-	"strings"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -17,6 +18,11 @@ func resourceBlockStorage() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: resourceBlockStorageCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -35,6 +41,24 @@ func resourceBlockStorage() *schema.Resource {
 				Required: true,
 			},
 
+			// Block storage can only be resized to a larger size. If replace_on_shrink
+			// is set, shrinking it replaces it with new, empty block storage instead.
+			"replace_on_shrink": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"deletion_protection": deletionProtectionSchema(),
+
+			// Destroying attached block storage fails unless force_detach is set,
+			// since it detaches the block storage from a running instance.
+			"force_detach": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -50,6 +74,8 @@ func resourceBlockStorage() *schema.Resource {
 				Computed: true,
 			},
 
+			// Removing instance detaches the block storage. instance is only read back while it is set,
+			// so that block storage attached with a vultr_block_storage_attachment is left alone.
 			"instance": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -74,7 +100,7 @@ func resourceBlockStorageCreate(d *schema.ResourceData, meta interface{}) error
 
 	instance := d.Get("instance")
 	if instance != "" {
-		if err := client.AttachBlockStorage(d.Id(), instance.(string)); err != nil {
+		if err := client.AttachBlockStorage(d.Id(), instance.(string), false); err != nil {
 			return fmt.Errorf("Error attaching newly created block storage (%s) to instance %q: %v", d.Id(), instance.(string), err)
 		}
 	}
@@ -87,7 +113,7 @@ func resourceBlockStorageRead(d *schema.ResourceData, meta interface{}) error {
 
 	storage, err := client.GetBlockStorage(d.Id())
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "Invalid block storage") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing block storage (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -100,7 +126,11 @@ func resourceBlockStorageRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", storage.Name)
 	d.Set("region_id", storage.RegionID)
 	d.Set("status", storage.Status)
-	d.Set("instance", storage.AttachedTo)
+	// Only read instance back while it is set in the state. Reads while an update changes it
+	// must not overwrite the new value before it has been applied.
+	if old, _ := d.GetChange("instance"); old.(string) != "" && !d.HasChange("instance") {
+		d.Set("instance", storage.AttachedTo)
+	}
 
 	return nil
 }
@@ -121,9 +151,15 @@ func resourceBlockStorageUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if d.HasChange("size") {
 		log.Printf("[INFO] Resizing block storage (%s)", d.Id())
-		_, new := d.GetChange("size")
+		old, new := d.GetChange("size")
 		if err := client.ResizeBlockStorage(d.Id(), new.(int)); err != nil {
-			return fmt.Errorf("Error resizing block storage (%s) to %q: %v", d.Id(), new.(int), err)
+			return fmt.Errorf("Error resizing block storage (%s) to %d GB: %v", d.Id(), new.(int), err)
+		}
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "block storage", "size", resourceBlockStorageRead, strconv.Itoa(new.(int)), []string{strconv.Itoa(old.(int))}); err != nil {
+			return err
+		}
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "block storage", "status", resourceBlockStorageRead, "active", []string{"pending", "resizing"}); err != nil {
+			return err
 		}
 		d.SetPartial("size")
 	}
@@ -132,38 +168,89 @@ func resourceBlockStorageUpdate(d *schema.ResourceData, meta interface{}) error
 		old, new := d.GetChange("instance")
 		if old != "" {
 			log.Printf("[INFO] Detaching block storage (%s)", d.Id())
-			if err := client.DetachBlockStorage(d.Id()); err != nil {
+			if err := client.DetachBlockStorage(d.Id(), false); err != nil {
 				return fmt.Errorf("Error detaching block storage (%s): %v", d.Id(), err)
 			}
 		}
-		if new != "" {
+		// Imported block storage has no instance in its state, but may already be attached to it.
+		attached := false
+		if old == "" && new != "" {
+			storage, err := client.GetBlockStorage(d.Id())
+			if err != nil {
+				return fmt.Errorf("Error getting block storage (%s): %v", d.Id(), err)
+			}
+			attached = storage.AttachedTo == new
+		}
+		if new != "" && !attached {
 			log.Printf("[INFO] Attaching block storage (%s)", d.Id())
-			if err := client.AttachBlockStorage(d.Id(), new.(string)); err != nil {
+			if err := client.AttachBlockStorage(d.Id(), new.(string), false); err != nil {
 				return fmt.Errorf("Error attaching block storage (%s) to %q: %v", d.Id(), new.(string), err)
 			}
 		}
 		d.SetPartial("instance")
 	}
 
+	d.Partial(false)
+
 	return resourceBlockStorageRead(d, meta)
 }
 
 func resourceBlockStorageDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
+	if err := checkDeletionProtection(d, "block storage"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Destroying block storage (%s)", d.Id())
 
-	instance := d.Get("instance").(string)
-	if instance != "" {
+	// The block storage may have been detached by a vultr_block_storage_attachment
+	// since it was last read, so check whether it is still attached.
+	storage, err := client.GetBlockStorage(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("Error getting block storage (%s): %v", d.Id(), err)
+	}
+	if storage.AttachedTo != "" {
+		if !d.Get("force_detach").(bool) {
+			return fmt.Errorf("Cannot destroy block storage (%s) because it is attached to instance (%s). Detach it first or set %q to detach it and delete its data", d.Id(), storage.AttachedTo, "force_detach")
+		}
 		// We need to detach block storage before deleting it
-		log.Printf("[INFO] Dettaching block storage (%s) before deleting it.", d.Id())
-		if err := client.DetachBlockStorage(d.Id()); err != nil {
+		log.Printf("[INFO] Dettaching block storage (%s) from instance (%s) before deleting it.", d.Id(), storage.AttachedTo)
+		if err := client.DetachBlockStorage(d.Id(), false); err != nil {
 			return fmt.Errorf("Error detaching block storage (%s): %v", d.Id(), err)
 		}
+		if err := waitForBlockStorageAttachment(client, d.Id(), "", d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
 	}
-	if err := client.DeleteBlockStorage(d.Id()); err != nil {
+	if err := client.DeleteBlockStorage(d.Id()); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying block storage (%s): %v", d.Id(), err)
 	}
 
 	return nil
 }
+
+// resourceBlockStorageCustomizeDiff rejects shrinking block storage, which the API does not support,
+// unless the block storage should be replaced instead. Neither is allowed with deletion protection.
+func resourceBlockStorageCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateDeletionProtection(d, "block storage", resourceBlockStorage().Schema); err != nil {
+		return err
+	}
+	if d.Id() == "" || !d.HasChange("size") || !d.NewValueKnown("size") {
+		return nil
+	}
+	old, new := d.GetChange("size")
+	if new.(int) >= old.(int) {
+		return nil
+	}
+	if d.Get("replace_on_shrink").(bool) {
+		if isDeletionProtected(d) {
+			return deletionProtectionReplaceError(d, "block storage", "size")
+		}
+		return d.ForceNew("size")
+	}
+	return fmt.Errorf("Block storage (%s) cannot be shrunk from %d GB to %d GB; block storage can only be resized to a larger size. Set %q to replace it with new, empty block storage instead", d.Id(), old.(int), new.(int), "replace_on_shrink")
+}
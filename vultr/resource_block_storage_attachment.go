@@ -0,0 +1,162 @@
+package vultr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageAttachmentCreate,
+		Read:   resourceBlockStorageAttachmentRead,
+		Update: resourceBlockStorageAttachmentUpdate,
+		Delete: resourceBlockStorageAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"block_storage_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"live": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	blockStorageID := d.Get("block_storage_id").(string)
+	instanceID := d.Get("instance_id").(string)
+
+	// Attaching block storage restarts the instance unless it is live,
+	// so only change the block storage of an instance one at a time.
+	vultrMutexKV.Lock(instanceID)
+	defer vultrMutexKV.Unlock(instanceID)
+
+	log.Printf("[INFO] Attaching block storage (%s) to instance (%s)", blockStorageID, instanceID)
+	if err := client.AttachBlockStorage(blockStorageID, instanceID, d.Get("live").(bool)); err != nil {
+		return fmt.Errorf("Error attaching block storage (%s) to instance (%s): %v", blockStorageID, instanceID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, blockStorageID))
+
+	if err := waitForBlockStorageAttachment(client, blockStorageID, instanceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceBlockStorageAttachmentRead(d, meta)
+}
+
+func resourceBlockStorageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	instanceID, blockStorageID, err := parseStringSlashString(d.Id(), "block storage attachment ID", "instance-id", "block-storage-id")
+	if err != nil {
+		return err
+	}
+
+	storage, err := client.GetBlockStorage(blockStorageID)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Removing block storage attachment (%s) because the block storage is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error getting block storage (%s): %v", blockStorageID, err)
+	}
+	if storage.AttachedTo != instanceID {
+		log.Printf("[WARN] Removing block storage attachment (%s) because it is gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("block_storage_id", blockStorageID)
+	d.Set("instance_id", instanceID)
+
+	return nil
+}
+
+func resourceBlockStorageAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceBlockStorageAttachmentRead(d, meta)
+}
+
+func resourceBlockStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	instanceID, blockStorageID, err := parseStringSlashString(d.Id(), "block storage attachment ID", "instance-id", "block-storage-id")
+	if err != nil {
+		return err
+	}
+
+	vultrMutexKV.Lock(instanceID)
+	defer vultrMutexKV.Unlock(instanceID)
+
+	storage, err := client.GetBlockStorage(blockStorageID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("Error getting block storage (%s): %v", blockStorageID, err)
+	}
+	if storage.AttachedTo != instanceID {
+		return nil
+	}
+
+	log.Printf("[INFO] Detaching block storage (%s) from instance (%s)", blockStorageID, instanceID)
+	if err := client.DetachBlockStorage(blockStorageID, d.Get("live").(bool)); err != nil {
+		return fmt.Errorf("Error detaching block storage (%s) from instance (%s): %v", blockStorageID, instanceID, err)
+	}
+
+	return waitForBlockStorageAttachment(client, blockStorageID, "", d.Timeout(schema.TimeoutDelete))
+}
+
+// waitForBlockStorageAttachment waits for the block storage to be attached to the instance,
+// or to be detached if the instance ID is empty.
+func waitForBlockStorageAttachment(client *Client, blockStorageID, instanceID string, timeout time.Duration) error {
+	log.Printf("[INFO] Waiting for block storage (%s) to be attached to %q", blockStorageID, instanceID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			storage, err := client.GetBlockStorage(blockStorageID)
+			if err != nil {
+				return nil, "", err
+			}
+			if storage.AttachedTo != instanceID {
+				return storage, "pending", nil
+			}
+			return storage, "done", nil
+		},
+		Timeout:    timeout,
+		Delay:      waitDelay,
+		MinTimeout: waitMinTimeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for block storage (%s) to be attached to %q: %v", blockStorageID, instanceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceBlockStorageAttachment(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_block_storage_attachment", func(id string) bool {
+			for _, storage := range api.blockStorage {
+				if storage["attached_to_SUBID"] != nil {
+					return true
+				}
+			}
+			return false
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageAttachmentConfig("a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vultr_block_storage_attachment.test", "instance_id", "vultr_instance.a", "id"),
+					resource.TestCheckResourceAttrPair("vultr_block_storage_attachment.test", "block_storage_id", "vultr_block_storage.test", "id"),
+					api.checkCalls("block/attach", 1),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageAttachmentConfig("b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vultr_block_storage_attachment.test", "instance_id", "vultr_instance.b", "id"),
+					api.checkCalls("block/attach", 2),
+					api.checkCalls("block/detach", 1),
+				),
+			},
+			{
+				Config:                  api.providerConfig() + testAccResourceBlockStorageAttachmentConfig("b"),
+				ResourceName:            "vultr_block_storage_attachment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"live"},
+			},
+		},
+	})
+}
+
+func testAccResourceBlockStorageAttachmentConfig(instance string) string {
+	return fmt.Sprintf(`
+resource "vultr_instance" "a" {
+	name      = "a"
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+}
+
+resource "vultr_instance" "b" {
+	name      = "b"
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+}
+
+resource "vultr_block_storage" "test" {
+	name      = "test"
+	region_id = 1
+	size      = 10
+}
+
+resource "vultr_block_storage_attachment" "test" {
+	block_storage_id = "${vultr_block_storage.test.id}"
+	instance_id      = "${vultr_instance.%s.id}"
+	live             = true
+}
+`, instance)
+}
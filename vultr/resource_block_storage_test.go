@@ -0,0 +1,170 @@
+package vultr
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceBlockStorage(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_block_storage", in(api.blockStorage)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageConfig("test", 10, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("vultr_block_storage.test", "instance"),
+					resource.TestCheckResourceAttr("vultr_block_storage.test", "name", "test"),
+					resource.TestCheckResourceAttr("vultr_block_storage.test", "region_id", "1"),
+					resource.TestCheckResourceAttr("vultr_block_storage.test", "size", "10"),
+					resource.TestCheckResourceAttr("vultr_block_storage.test", "status", "active"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageConfig("renamed", 20, "${vultr_instance.test.id}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vultr_block_storage.test", "instance", "vultr_instance.test", "id"),
+					resource.TestCheckResourceAttr("vultr_block_storage.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("vultr_block_storage.test", "size", "20"),
+				),
+			},
+			{
+				// instance is only read back once it is set, so it is not imported.
+				Config:                  api.providerConfig() + testAccResourceBlockStorageConfig("renamed", 20, "${vultr_instance.test.id}"),
+				ResourceName:            "vultr_block_storage.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "force_detach", "instance", "replace_on_shrink"},
+			},
+			{
+				// Removing the instance detaches the block storage.
+				Config: api.providerConfig() + testAccResourceBlockStorageConfig("renamed", 20, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_block_storage.test", "instance", ""),
+					api.checkCalls("block/detach", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBlockStorageShrink(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_block_storage", in(api.blockStorage)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageShrinkConfig(20, false),
+				Check:  resource.TestCheckResourceAttr("vultr_block_storage.test", "size", "20"),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceBlockStorageShrinkConfig(10, false),
+				ExpectError: regexp.MustCompile(`cannot be shrunk from 20 GB to 10 GB`),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageShrinkConfig(10, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_block_storage.test", "size", "10"),
+					api.checkCalls("block/create", 2),
+					api.checkCalls("block/delete", 1),
+					api.checkCalls("block/resize", 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceBlockStorageShrinkConfig(size int, replace bool) string {
+	return fmt.Sprintf(`
+resource "vultr_block_storage" "test" {
+	name              = "test"
+	region_id         = 1
+	replace_on_shrink = %t
+	size              = %d
+}
+`, replace, size)
+}
+
+func testAccResourceBlockStorageConfig(name string, size int, instance string) string {
+	return fmt.Sprintf(`
+resource "vultr_instance" "test" {
+	name      = "test"
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+}
+
+resource "vultr_block_storage" "test" {
+	force_detach = true
+	instance     = "%s"
+	name         = "%s"
+	region_id    = 1
+	size         = %d
+}
+`, instance, name, size)
+}
+
+func TestAccResourceBlockStorageProtection(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_block_storage", in(api.blockStorage)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageProtectionConfig(true, false, 20),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceBlockStorageProtectionConfig(false, false, 10),
+				ExpectError: regexp.MustCompile(`Cannot replace block storage \(\d+\) to change "size" because "deletion_protection" is enabled`),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceBlockStorageProtectionConfig(true, false, 20),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Cannot destroy block storage \(\d+\) because "deletion_protection" is enabled`),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageProtectionConfig(false, false, 20),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceBlockStorageProtectionConfig(false, false, 20),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Cannot destroy block storage \(\d+\) because it is attached to instance \(\d+\)`),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceBlockStorageProtectionConfig(false, true, 20),
+			},
+		},
+	})
+}
+
+func testAccResourceBlockStorageProtectionConfig(deletionProtection, forceDetach bool, size int) string {
+	return fmt.Sprintf(`
+resource "vultr_instance" "test" {
+	name      = "test"
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+}
+
+resource "vultr_block_storage" "test" {
+	deletion_protection = %[1]t
+	force_detach        = %[2]t
+	instance            = "${vultr_instance.test.id}"
+	name                = "test"
+	region_id           = 1
+	replace_on_shrink   = true
+	size                = %[3]d
+}
+`, deletionProtection, forceDetach, size)
+}
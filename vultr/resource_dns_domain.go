@@ -3,7 +3,6 @@ package vultr
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/JamesClonk/vultr/lib"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,8 +17,11 @@ func resourceDNSDomain() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceDNSDomainCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
+			"deletion_protection": deletionProtectionSchema(),
+
 			"domain": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -77,7 +79,7 @@ func resourceDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 	// Find the default record for the domain.
 	records, err := client.GetDNSRecords(dnsDomain.Domain)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "Invalid domain") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing DNS domain (%s) because it has no default record", d.Id())
 			d.SetId("")
 			return nil
@@ -108,6 +110,10 @@ func resourceDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 func resourceDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
+	if !d.HasChange("ip") {
+		return resourceDNSDomainRead(d, meta)
+	}
+
 	// Find the default record for the domain.
 	records, err := client.GetDNSRecords(d.Id())
 	if err != nil {
@@ -138,11 +144,19 @@ func resourceDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceDNSDomainDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
+	if err := checkDeletionProtection(d, "DNS domain"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Destroying DNS domain (%s)", d.Id())
 
-	if err := client.DeleteDNSDomain(d.Id()); err != nil {
+	if err := client.DeleteDNSDomain(d.Id()); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying DNS domain (%s): %v", d.Id(), err)
 	}
 
 	return nil
 }
+
+func resourceDNSDomainCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return validateDeletionProtection(d, "DNS domain", resourceDNSDomain().Schema)
+}
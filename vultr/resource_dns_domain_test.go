@@ -0,0 +1,47 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceDNSDomain(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_dns_domain", in(api.domains)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceDNSDomainConfig("192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_dns_domain.test", "domain", "example.com"),
+					resource.TestCheckResourceAttr("vultr_dns_domain.test", "ip", "192.0.2.10"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceDNSDomainConfig("192.0.2.20"),
+				Check:  resource.TestCheckResourceAttr("vultr_dns_domain.test", "ip", "192.0.2.20"),
+			},
+			{
+				Config:                  api.providerConfig() + testAccResourceDNSDomainConfig("192.0.2.20"),
+				ResourceName:            "vultr_dns_domain.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func testAccResourceDNSDomainConfig(ip string) string {
+	return fmt.Sprintf(`
+resource "vultr_dns_domain" "test" {
+	domain = "example.com"
+	ip     = "%s"
+}
+`, ip)
+}
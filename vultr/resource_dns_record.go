@@ -3,6 +3,8 @@ package vultr
 import (
 	"fmt"
 	"log"
+	"net"
+	"strconv"
 	"strings"
 
 	"github.com/JamesClonk/vultr/lib"
@@ -18,11 +20,44 @@ func resourceDNSRecord() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceDNSRecordCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
+			// caa is the data of a CAA record as structured fields.
+			"caa": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"data", "srv"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flags": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntBetween(0, 255),
+						},
+
+						"tag": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCAATag,
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			// data is computed when the record is given by the caa or srv block.
 			"data": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"domain": {
@@ -41,10 +76,43 @@ func resourceDNSRecord() *schema.Resource {
 				Optional: true,
 			},
 
+			// srv is the data of a SRV record as structured fields.
+			// The priority of the record is given by the priority argument.
+			"srv": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"caa", "data"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntBetween(0, 65535),
+						},
+
+						"target": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateHostname,
+						},
+
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntBetween(0, 65535),
+						},
+					},
+				},
+			},
+
 			"type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDNSRecordType,
 			},
 
 			"ttl": {
@@ -59,7 +127,7 @@ func resourceDNSRecord() *schema.Resource {
 func resourceDNSRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
-	data := d.Get("data").(string)
+	data := dnsRecordData(d)
 	domain := d.Get("domain").(string)
 	name := d.Get("name").(string)
 	recordType := d.Get("type").(string)
@@ -108,7 +176,7 @@ func resourceDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
 
 	records, err := client.GetDNSRecords(domain)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "Invalid domain") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing DNS record (%s) because the domain is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -130,10 +198,14 @@ func resourceDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	srv, caa := flattenDNSRecordData(record.Type, record.Data)
+
+	d.Set("caa", caa)
 	d.Set("data", record.Data)
 	d.Set("domain", domain)
 	d.Set("name", record.Name)
 	d.Set("priority", record.Priority)
+	d.Set("srv", srv)
 	d.Set("ttl", record.TTL)
 	d.Set("type", record.Type)
 
@@ -149,7 +221,7 @@ func resourceDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	record := lib.DNSRecord{
-		Data:     d.Get("data").(string),
+		Data:     dnsRecordData(d),
 		RecordID: id,
 		Name:     d.Get("name").(string),
 		Priority: d.Get("priority").(int),
@@ -174,9 +246,164 @@ func resourceDNSRecordDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Destroying DNS record (%s)", d.Id())
 
-	if err := client.DeleteDNSRecord(domain, id); err != nil {
+	if err := client.DeleteDNSRecord(domain, id); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying DNS record (%s): %v", d.Id(), err)
 	}
 
 	return nil
 }
+
+// resourceDNSRecordCustomizeDiff validates the data of the record for its type and marks
+// the data and its structured fields as changing together.
+func resourceDNSRecordCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	recordType := d.Get("type").(string)
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	if srv, ok := d.GetOk("srv"); ok && len(srv.([]interface{})) > 0 && recordType != "SRV" {
+		return fmt.Errorf("%q can only be used with records of type %q", "srv", "SRV")
+	}
+	if caa, ok := d.GetOk("caa"); ok && len(caa.([]interface{})) > 0 && recordType != "CAA" {
+		return fmt.Errorf("%q can only be used with records of type %q", "caa", "CAA")
+	}
+
+	if d.HasChange("srv") || d.HasChange("caa") {
+		return d.SetNewComputed("data")
+	}
+	if !d.NewValueKnown("data") {
+		return nil
+	}
+	data, ok := d.GetOk("data")
+	if !ok && d.Id() == "" {
+		return fmt.Errorf("One of %q, %q and %q must be provided", "caa", "data", "srv")
+	}
+	if !d.HasChange("data") {
+		return nil
+	}
+	if err := validateDNSRecordData(recordType, data.(string)); err != nil {
+		return err
+	}
+	// The structured fields are parsed from the new data when the record is read.
+	switch {
+	case d.Id() == "":
+		return nil
+	case recordType == "SRV":
+		return d.SetNewComputed("srv")
+	case recordType == "CAA":
+		return d.SetNewComputed("caa")
+	}
+	return nil
+}
+
+// validateDNSRecordData ensures that the data of a record is valid for its type.
+func validateDNSRecordData(recordType, data string) error {
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(data); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("Records of type %s require an IPv4 address as %q, got %q", recordType, "data", data)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(data); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("Records of type %s require an IPv6 address as %q, got %q", recordType, "data", data)
+		}
+	case "CNAME", "MX", "NS":
+		if _, errs := validateHostname(data, "data"); len(errs) > 0 {
+			return fmt.Errorf("Records of type %s require a fully qualified domain name as %q, got %q", recordType, "data", data)
+		}
+	case "CAA":
+		if _, ok := parseCAARecordData(data); !ok {
+			return fmt.Errorf("Records of type %s require %q of the form <flags> <tag> \"<value>\", got %q", recordType, "data", data)
+		}
+	case "SRV":
+		if _, ok := parseSRVRecordData(data); !ok {
+			return fmt.Errorf("Records of type %s require %q of the form <weight> <port> <target>, got %q", recordType, "data", data)
+		}
+	case "SSHFP":
+		if f := strings.Fields(data); len(f) != 3 || !isUint(f[0]) || !isUint(f[1]) {
+			return fmt.Errorf("Records of type %s require %q of the form <algorithm> <type> <fingerprint>, got %q", recordType, "data", data)
+		}
+	}
+	return nil
+}
+
+// dnsRecordData returns the data of the record, composed from the srv or caa block if they changed.
+// Otherwise, e.g. if the data argument changed, the structured fields are unknown and not used.
+func dnsRecordData(d *schema.ResourceData) string {
+	if srv := d.Get("srv").([]interface{}); d.HasChange("srv") && len(srv) > 0 {
+		m := srv[0].(map[string]interface{})
+		return fmt.Sprintf("%d %d %s", m["weight"].(int), m["port"].(int), m["target"].(string))
+	}
+	if caa := d.Get("caa").([]interface{}); d.HasChange("caa") && len(caa) > 0 {
+		m := caa[0].(map[string]interface{})
+		return fmt.Sprintf("%d %s %q", m["flags"].(int), m["tag"].(string), m["value"].(string))
+	}
+	return d.Get("data").(string)
+}
+
+// flattenDNSRecordData returns the srv and caa blocks for the data of a record.
+// They are empty unless the record is of the matching type and its data can be parsed.
+func flattenDNSRecordData(recordType, data string) ([]map[string]interface{}, []map[string]interface{}) {
+	var srv, caa []map[string]interface{}
+	switch recordType {
+	case "SRV":
+		if m, ok := parseSRVRecordData(data); ok {
+			srv = append(srv, m)
+		}
+	case "CAA":
+		if m, ok := parseCAARecordData(data); ok {
+			caa = append(caa, m)
+		}
+	}
+	return srv, caa
+}
+
+// parseSRVRecordData parses the data of a SRV record of the form <weight> <port> <target>.
+func parseSRVRecordData(data string) (map[string]interface{}, bool) {
+	f := strings.Fields(data)
+	if len(f) != 3 {
+		return nil, false
+	}
+	weight, err := strconv.Atoi(f[0])
+	if err != nil || weight < 0 || weight > 65535 {
+		return nil, false
+	}
+	port, err := strconv.Atoi(f[1])
+	if err != nil || port < 0 || port > 65535 {
+		return nil, false
+	}
+	return map[string]interface{}{
+		"port":   port,
+		"target": f[2],
+		"weight": weight,
+	}, true
+}
+
+// parseCAARecordData parses the data of a CAA record of the form <flags> <tag> "<value>".
+func parseCAARecordData(data string) (map[string]interface{}, bool) {
+	f := strings.SplitN(strings.TrimSpace(data), " ", 3)
+	if len(f) != 3 {
+		return nil, false
+	}
+	flags, err := strconv.Atoi(f[0])
+	if err != nil || flags < 0 || flags > 255 {
+		return nil, false
+	}
+	if _, errs := validateCAATag(f[1], "tag"); len(errs) > 0 {
+		return nil, false
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(f[2]))
+	if err != nil {
+		return nil, false
+	}
+	return map[string]interface{}{
+		"flags": flags,
+		"tag":   f[1],
+		"value": value,
+	}, true
+}
+
+func isUint(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
@@ -0,0 +1,194 @@
+package vultr
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceDNSRecord(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_dns_record", func(id string) bool {
+			domain, recordID, err := parseStringSlashInt(id, "DNS record ID", "domain", "record-ID")
+			if err != nil {
+				return false
+			}
+			for _, r := range api.records[domain] {
+				if r["RECORDID"] == recordID {
+					return true
+				}
+			}
+			return false
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceDNSRecordConfig("192.0.2.10", 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_dns_record.test", "data", "192.0.2.10"),
+					resource.TestCheckResourceAttr("vultr_dns_record.test", "domain", "example.com"),
+					resource.TestCheckResourceAttr("vultr_dns_record.test", "name", "api"),
+					resource.TestCheckResourceAttr("vultr_dns_record.test", "ttl", "300"),
+					resource.TestCheckResourceAttr("vultr_dns_record.test", "type", "A"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceDNSRecordConfig("192.0.2.20", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_dns_record.test", "data", "192.0.2.20"),
+					resource.TestCheckResourceAttr("vultr_dns_record.test", "ttl", "600"),
+				),
+			},
+			{
+				Config:            api.providerConfig() + testAccResourceDNSRecordConfig("192.0.2.20", 600),
+				ResourceName:      "vultr_dns_record.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDNSRecordStructured(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceDNSRecordStructuredConfig(5060, "letsencrypt.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_dns_record.srv", "data", "10 5060 sip.example.com"),
+					resource.TestCheckResourceAttr("vultr_dns_record.srv", "srv.0.port", "5060"),
+					resource.TestCheckResourceAttr("vultr_dns_record.caa", "data", `0 issue "letsencrypt.org"`),
+					resource.TestCheckResourceAttr("vultr_dns_record.caa", "caa.0.tag", "issue"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceDNSRecordStructuredConfig(5061, "example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_dns_record.srv", "data", "10 5061 sip.example.com"),
+					resource.TestCheckResourceAttr("vultr_dns_record.caa", "data", `0 issue "example.net"`),
+					api.checkCalls("dns/create_record", 2),
+				),
+			},
+			{
+				// Records given as data have their structured fields parsed from it.
+				Config: api.providerConfig() + testAccResourceDNSRecordStructuredConfig(5061, "example.net") + `
+resource "vultr_dns_record" "raw" {
+	data     = "0 443 www.example.com"
+	domain   = "${vultr_dns_domain.test.id}"
+	name     = "_https._tcp"
+	priority = 1
+	type     = "SRV"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_dns_record.raw", "srv.0.port", "443"),
+					resource.TestCheckResourceAttr("vultr_dns_record.raw", "srv.0.target", "www.example.com"),
+					resource.TestCheckResourceAttr("vultr_dns_record.raw", "srv.0.weight", "0"),
+				),
+			},
+			{
+				ResourceName:      "vultr_dns_record.caa",
+				Config:            api.providerConfig() + testAccResourceDNSRecordStructuredConfig(5061, "example.net"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDNSRecordValidation(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      api.providerConfig() + testAccResourceDNSRecordValidationConfig("SPF", "v=spf1 -all"),
+				ExpectError: regexp.MustCompile(`invalid DNS record type "SPF"`),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceDNSRecordValidationConfig("A", "2001:db8::1"),
+				ExpectError: regexp.MustCompile(`Records of type A require an IPv4 address`),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceDNSRecordValidationConfig("AAAA", "192.0.2.1"),
+				ExpectError: regexp.MustCompile(`Records of type AAAA require an IPv6 address`),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceDNSRecordValidationConfig("CNAME", "not a hostname"),
+				ExpectError: regexp.MustCompile(`Records of type CNAME require a fully qualified domain name`),
+			},
+		},
+	})
+}
+
+func testAccResourceDNSRecordStructuredConfig(port int, issuer string) string {
+	return fmt.Sprintf(`
+resource "vultr_dns_domain" "test" {
+	domain = "example.com"
+	ip     = "192.0.2.1"
+}
+
+resource "vultr_dns_record" "srv" {
+	domain   = "${vultr_dns_domain.test.id}"
+	name     = "_sip._tcp"
+	priority = 1
+	type     = "SRV"
+
+	srv {
+		port   = %d
+		target = "sip.example.com"
+		weight = 10
+	}
+}
+
+resource "vultr_dns_record" "caa" {
+	domain = "${vultr_dns_domain.test.id}"
+	name   = ""
+	type   = "CAA"
+
+	caa {
+		tag   = "issue"
+		value = "%s"
+	}
+}
+`, port, issuer)
+}
+
+func testAccResourceDNSRecordValidationConfig(recordType, data string) string {
+	return fmt.Sprintf(`
+resource "vultr_dns_record" "test" {
+	data   = "%s"
+	domain = "example.com"
+	name   = "test"
+	type   = "%s"
+}
+`, data, recordType)
+}
+
+func testAccResourceDNSRecordConfig(data string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vultr_dns_domain" "test" {
+	domain = "example.com"
+	ip     = "192.0.2.1"
+}
+
+resource "vultr_dns_record" "test" {
+	data   = "%s"
+	domain = "${vultr_dns_domain.test.id}"
+	name   = "api"
+	ttl    = %d
+	type   = "A"
+}
+`, data, ttl)
+}
@@ -3,7 +3,6 @@ package vultr
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -61,7 +60,7 @@ func resourceFirewallGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	firewallGroup, err := client.GetFirewallGroup(d.Id())
 	if err != nil {
-		if strings.HasPrefix(err.Error(), fmt.Sprintf("Firewall group with ID %v not found", d.Id())) {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing firewall group (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -95,7 +94,7 @@ func resourceFirewallGroupDelete(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[INFO] Destroying firewall group (%s)", d.Id())
 
-	if err := client.DeleteFirewallGroup(d.Id()); err != nil {
+	if err := client.DeleteFirewallGroup(d.Id()); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying firewall group (%s): %v", d.Id(), err)
 	}
 
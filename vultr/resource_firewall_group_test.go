@@ -0,0 +1,45 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceFirewallGroup(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_firewall_group", in(api.firewallGroups)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceFirewallGroupConfig("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_firewall_group.test", "description", "test"),
+					resource.TestCheckResourceAttr("vultr_firewall_group.test", "rule_count", "0"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceFirewallGroupConfig("updated"),
+				Check:  resource.TestCheckResourceAttr("vultr_firewall_group.test", "description", "updated"),
+			},
+			{
+				Config:            api.providerConfig() + testAccResourceFirewallGroupConfig("updated"),
+				ResourceName:      "vultr_firewall_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceFirewallGroupConfig(description string) string {
+	return fmt.Sprintf(`
+resource "vultr_firewall_group" "test" {
+	description = "%s"
+}
+`, description)
+}
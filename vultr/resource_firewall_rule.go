@@ -15,10 +15,12 @@ func resourceFirewallRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFirewallRuleCreate,
 		Read:   resourceFirewallRuleRead,
+		Update: resourceFirewallRuleUpdate,
 		Delete: resourceFirewallRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceFirewallRuleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"action": {
@@ -29,7 +31,6 @@ func resourceFirewallRule() *schema.Resource {
 			"cidr_block": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateCIDRNetworkAddress,
 			},
 
@@ -47,65 +48,37 @@ func resourceFirewallRule() *schema.Resource {
 			"from_port": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
+			},
+
+			"ip_type": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 
 			"notes": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"protocol": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateFirewallRuleProtocol,
 			},
 
 			"to_port": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 			},
 		},
 	}
 }
 
 func resourceFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Client)
-
-	_, cidrBlock, err := net.ParseCIDR(d.Get("cidr_block").(string))
-	if err != nil {
-		return fmt.Errorf("Error parsing %q for firewall rule: %v", "cidr_block", err)
-	}
 	firewallGroupID := d.Get("firewall_group_id").(string)
-	fromPort := d.Get("from_port").(int)
-	notes := d.Get("notes").(string)
-	protocol := d.Get("protocol").(string)
-	toPort := d.Get("to_port").(int)
-
-	_, fok := d.GetOk("from_port")
-	_, tok := d.GetOk("to_port")
-	if fok != tok {
-		return fmt.Errorf("Expected %q and %q to both be provided or both be empty", "from_port", "to_port")
-	}
-
-	if (protocol == "tcp" || protocol == "udp") && !fok {
-		return fmt.Errorf("%q and %q are required for protocol of type %q", "from_port", "to_port", protocol)
-	}
-
-	var port string
-	if fok {
-		if fromPort != toPort {
-			port = fmt.Sprintf("%d:%d", fromPort, toPort)
-		} else {
-			port = strconv.Itoa(fromPort)
-		}
-	}
 
 	log.Printf("[INFO] Creating new firewall rule")
-	id, err := client.CreateFirewallRule(firewallGroupID, protocol, port, cidrBlock, notes)
+	id, err := createFirewallRule(d, meta)
 	if err != nil {
 		return fmt.Errorf("Error creating firewall rule: %v", err)
 	}
@@ -123,9 +96,15 @@ func resourceFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	firewallRules, err := client.GetFirewallRules(firewallGroupID)
+	// Rules created before ip_type was introduced and imported rules could be of either type.
+	ipTypes := firewallRuleIPTypes
+	if ipType, ok := d.GetOk("ip_type"); ok {
+		ipTypes = []string{ipType.(string)}
+	}
+
+	firewallRules, err := getFirewallRules(client, firewallGroupID, ipTypes...)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "Invalid firewall group") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing firewall rule (%s) because the group is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -134,9 +113,9 @@ func resourceFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var firewallRule *lib.FirewallRule
-	for _, f := range firewallRules {
-		if f.RuleNumber == id {
-			firewallRule = &f
+	for i := range firewallRules {
+		if firewallRules[i].RuleNumber == id {
+			firewallRule = &firewallRules[i]
 			break
 		}
 	}
@@ -151,6 +130,7 @@ func resourceFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("cidr_block", firewallRule.Network.String())
 	d.Set("direction", "in")
 	d.Set("firewall_group_id", firewallGroupID)
+	d.Set("ip_type", firewallRuleIPType(firewallRule.Network))
 	d.Set("notes", firewallRule.Notes)
 	d.Set("protocol", firewallRule.Protocol)
 	from, to, err := splitFirewallRule(firewallRule.Port)
@@ -163,6 +143,54 @@ func resourceFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceFirewallRuleUpdate replaces the rule with one matching the new configuration.
+// The API cannot change an existing rule, so the new rule is created before the old one
+// is deleted to ensure that traffic matching both is never dropped.
+func resourceFirewallRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	firewallGroupID, oldID, err := parseStringSlashInt(d.Id(), "firewall rule ID", "firewall-group-id", "firewall-rule-number")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating replacement for firewall rule (%s)", d.Id())
+	id, err := createFirewallRule(d, meta)
+	if err != nil {
+		return fmt.Errorf("Error creating replacement for firewall rule (%s): %v", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Destroying replaced firewall rule (%s)", d.Id())
+	if err := client.DeleteFirewallRule(oldID, firewallGroupID); err != nil && !isNotFoundError(err) {
+		// Keep tracking the old rule and remove its replacement, so that the update can be retried.
+		// The replacement was added last, so removing it does not renumber the old rule.
+		if rerr := client.DeleteFirewallRule(id, firewallGroupID); rerr != nil && !isNotFoundError(rerr) {
+			return fmt.Errorf("Error destroying replaced firewall rule (%s/%d): %v. Its replacement (%s/%d) was left behind because it could not be destroyed either: %v", firewallGroupID, oldID, err, firewallGroupID, id, rerr)
+		}
+		return fmt.Errorf("Error destroying replaced firewall rule (%s/%d): %v", firewallGroupID, oldID, err)
+	}
+
+	// Deleting the old rule may have renumbered the new one, so look it up again.
+	_, cidrBlock, _ := net.ParseCIDR(d.Get("cidr_block").(string))
+	ipType := firewallRuleIPType(cidrBlock)
+	firewallRules, err := getFirewallRules(client, firewallGroupID, ipType)
+	if err != nil {
+		return fmt.Errorf("Error getting firewall rules (%s): %v", firewallGroupID, err)
+	}
+	key := firewallRuleKey{
+		cidrBlock: cidrBlock.String(),
+		fromPort:  d.Get("from_port").(int),
+		notes:     d.Get("notes").(string),
+		protocol:  d.Get("protocol").(string),
+		toPort:    d.Get("to_port").(int),
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", firewallGroupID, findFirewallRule(firewallRules, id, key)))
+	d.Set("ip_type", ipType)
+
+	return resourceFirewallRuleRead(d, meta)
+}
+
 func resourceFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
@@ -173,13 +201,102 @@ func resourceFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[INFO] Destroying firewall rule (%s)", d.Id())
 
-	if err := client.DeleteFirewallRule(id, firewallGroupID); err != nil {
+	if err := client.DeleteFirewallRule(id, firewallGroupID); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying firewall rule (%s): %v", d.Id(), err)
 	}
 
 	return nil
 }
 
+// resourceFirewallRuleCustomizeDiff marks the IP type as changing along with the CIDR block.
+func resourceFirewallRuleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("cidr_block") {
+		return d.SetNewComputed("ip_type")
+	}
+	return nil
+}
+
+// createFirewallRule creates a firewall rule from the configuration and returns its rule number.
+func createFirewallRule(d *schema.ResourceData, meta interface{}) (int, error) {
+	client := meta.(*Client)
+
+	_, cidrBlock, err := net.ParseCIDR(d.Get("cidr_block").(string))
+	if err != nil {
+		return 0, fmt.Errorf("Error parsing %q: %v", "cidr_block", err)
+	}
+	firewallGroupID := d.Get("firewall_group_id").(string)
+	fromPort := d.Get("from_port").(int)
+	notes := d.Get("notes").(string)
+	protocol := d.Get("protocol").(string)
+	toPort := d.Get("to_port").(int)
+
+	_, fok := d.GetOk("from_port")
+	_, tok := d.GetOk("to_port")
+	if fok != tok {
+		return 0, fmt.Errorf("Expected %q and %q to both be provided or both be empty", "from_port", "to_port")
+	}
+
+	if (protocol == "tcp" || protocol == "udp") && !fok {
+		return 0, fmt.Errorf("%q and %q are required for protocol of type %q", "from_port", "to_port", protocol)
+	}
+
+	return client.CreateFirewallRule(firewallGroupID, protocol, firewallRulePort(fromPort, toPort), cidrBlock, notes)
+}
+
+// findFirewallRule returns the number of the rule matching the key. It prefers the given number
+// and otherwise picks the highest numbered match, since new rules are added to the end of the list.
+func findFirewallRule(firewallRules []lib.FirewallRule, number int, key firewallRuleKey) int {
+	found := number
+	for _, f := range firewallRules {
+		if k, err := newFirewallRuleKey(f); err != nil || k != key {
+			continue
+		}
+		if f.RuleNumber == number {
+			return number
+		}
+		found = f.RuleNumber
+	}
+	return found
+}
+
+// firewallRuleIPTypes are the IP types of firewall rules, which the API lists separately.
+var firewallRuleIPTypes = []string{"v4", "v6"}
+
+// firewallRuleIPType returns the IP type of a firewall rule matching the given network.
+func firewallRuleIPType(network *net.IPNet) string {
+	if network.IP.To4() != nil {
+		return "v4"
+	}
+	return "v6"
+}
+
+// getFirewallRules returns the rules of the given IP types in a firewall group.
+// The API numbers the IPv4 and IPv6 rules of a group together, so rule numbers are unique across types.
+func getFirewallRules(client *Client, firewallGroupID string, ipTypes ...string) ([]lib.FirewallRule, error) {
+	var firewallRules []lib.FirewallRule
+	for _, ipType := range ipTypes {
+		rules, err := client.GetFirewallRulesByIPType(firewallGroupID, ipType)
+		if err != nil {
+			return nil, err
+		}
+		firewallRules = append(firewallRules, rules...)
+	}
+	return firewallRules, nil
+}
+
+// firewallRulePort returns the port or port range of a firewall rule in the format
+// expected by the API, or an empty string if the rule does not have ports.
+func firewallRulePort(from, to int) string {
+	switch {
+	case from == 0:
+		return ""
+	case from != to:
+		return fmt.Sprintf("%d:%d", from, to)
+	default:
+		return strconv.Itoa(from)
+	}
+}
+
 func splitFirewallRule(portRange string) (int, int, error) {
 	if len(portRange) == 0 || strings.TrimSpace(portRange) == "-" {
 		return 0, 0, nil
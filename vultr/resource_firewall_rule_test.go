@@ -1,9 +1,103 @@
 package vultr
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
 )
 
+func TestAccResourceFirewallRule(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_firewall_rule", func(id string) bool {
+			groupID, number, err := parseStringSlashInt(id, "firewall rule ID", "firewall-group-id", "firewall-rule-number")
+			return err == nil && number <= len(api.firewallRules[groupID])
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceFirewallRuleConfig("10.0.0.0/8", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "action", "accept"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "direction", "in"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "from_port", "8000"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "ip_type", "v4"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "notes", "test"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "to_port", "9000"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.v6", "cidr_block", "2001:db8::/32"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.v6", "ip_type", "v6"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.v6", "protocol", "icmp"),
+				),
+			},
+			{
+				// The rule is replaced in place by creating the new rule before deleting the old one.
+				Config: api.providerConfig() + testAccResourceFirewallRuleConfig("10.0.0.0/8", "replaced"),
+				Check: resource.ComposeTestCheckFunc(
+					// Deleting the old rule renumbers the new one.
+					resource.TestMatchResourceAttr("vultr_firewall_rule.test", "id", regexp.MustCompile("/1$")),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "notes", "replaced"),
+					api.checkCalls("firewall/rule_create", 3),
+					api.checkCalls("firewall/rule_delete", 1),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceFirewallRuleConfig("2001:db8:1::/48", "replaced"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "cidr_block", "2001:db8:1::/48"),
+					resource.TestCheckResourceAttr("vultr_firewall_rule.test", "ip_type", "v6"),
+					api.checkCalls("firewall/rule_create", 4),
+					api.checkCalls("firewall/rule_delete", 2),
+				),
+			},
+			{
+				Config:            api.providerConfig() + testAccResourceFirewallRuleConfig("2001:db8:1::/48", "replaced"),
+				ResourceName:      "vultr_firewall_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:            api.providerConfig() + testAccResourceFirewallRuleConfig("2001:db8:1::/48", "replaced"),
+				ResourceName:      "vultr_firewall_rule.v6",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceFirewallRuleConfig(cidrBlock, notes string) string {
+	return fmt.Sprintf(`
+resource "vultr_firewall_group" "test" {
+	description = "test"
+}
+
+resource "vultr_firewall_rule" "test" {
+	cidr_block        = "%s"
+	firewall_group_id = "${vultr_firewall_group.test.id}"
+	from_port         = 8000
+	notes             = "%s"
+	protocol          = "tcp"
+	to_port           = 9000
+}
+
+resource "vultr_firewall_group" "v6" {
+	description = "v6"
+}
+
+resource "vultr_firewall_rule" "v6" {
+	cidr_block        = "2001:db8::/32"
+	firewall_group_id = "${vultr_firewall_group.v6.id}"
+	protocol          = "icmp"
+}
+`, cidrBlock, notes)
+}
+
 func TestSplitFirewallRule(t *testing.T) {
 	cases := []struct {
 		portRange string
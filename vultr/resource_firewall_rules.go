@@ -0,0 +1,251 @@
+package vultr
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+
+	"github.com/JamesClonk/vultr/lib"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceFirewallRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirewallRulesCreate,
+		Read:   resourceFirewallRulesRead,
+		Update: resourceFirewallRulesUpdate,
+		Delete: resourceFirewallRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"firewall_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+
+						"from_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"notes": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateFirewallRuleProtocol,
+						},
+
+						"to_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// firewallRuleKey identifies a firewall rule by everything but its rule number,
+// since the API renumbers the rules of a group when one of them is deleted.
+type firewallRuleKey struct {
+	cidrBlock string
+	fromPort  int
+	notes     string
+	protocol  string
+	toPort    int
+}
+
+func resourceFirewallRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("firewall_group_id").(string))
+
+	if err := applyFirewallRules(d, meta); err != nil {
+		return err
+	}
+
+	return resourceFirewallRulesRead(d, meta)
+}
+
+func resourceFirewallRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	firewallRules, err := getFirewallRules(client, d.Id(), firewallRuleIPTypes...)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Removing firewall rules (%s) because the group is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error getting firewall rules (%s): %v", d.Id(), err)
+	}
+
+	rules := make([]map[string]interface{}, 0, len(firewallRules))
+	for _, f := range firewallRules {
+		key, err := newFirewallRuleKey(f)
+		if err != nil {
+			return fmt.Errorf("Error parsing port range for firewall rules (%s): %v", d.Id(), err)
+		}
+		rules = append(rules, map[string]interface{}{
+			"cidr_block": key.cidrBlock,
+			"from_port":  key.fromPort,
+			"notes":      key.notes,
+			"protocol":   key.protocol,
+			"to_port":    key.toPort,
+		})
+	}
+
+	d.Set("firewall_group_id", d.Id())
+	if err := d.Set("rule", rules); err != nil {
+		return fmt.Errorf("Error setting %q for firewall rules (%s): %v", "rule", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceFirewallRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("rule") {
+		if err := applyFirewallRules(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceFirewallRulesRead(d, meta)
+}
+
+func resourceFirewallRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	firewallRules, err := getFirewallRules(client, d.Id(), firewallRuleIPTypes...)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("Error getting firewall rules (%s): %v", d.Id(), err)
+	}
+
+	numbers := make([]int, 0, len(firewallRules))
+	for _, f := range firewallRules {
+		numbers = append(numbers, f.RuleNumber)
+	}
+
+	log.Printf("[INFO] Destroying firewall rules (%s)", d.Id())
+	return deleteFirewallRules(client, d.Id(), numbers)
+}
+
+// applyFirewallRules makes the rules of the firewall group match the configured rules.
+// Rules that already exist are kept, so only the difference is created and deleted,
+// including rules that were added outside of Terraform. Missing rules are created
+// before stale rules are deleted so that changing a rule never drops traffic.
+func applyFirewallRules(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	var keys []firewallRuleKey
+	wanted := make(map[firewallRuleKey]bool)
+	for _, r := range d.Get("rule").(*schema.Set).List() {
+		key, err := expandFirewallRuleKey(r.(map[string]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error in firewall rules (%s): %v", d.Id(), err)
+		}
+		keys = append(keys, key)
+		wanted[key] = true
+	}
+
+	firewallRules, err := getFirewallRules(client, d.Id(), firewallRuleIPTypes...)
+	if err != nil {
+		return fmt.Errorf("Error getting firewall rules (%s): %v", d.Id(), err)
+	}
+
+	var stale []int
+	for _, f := range firewallRules {
+		key, err := newFirewallRuleKey(f)
+		if err == nil && wanted[key] {
+			// Keep the first matching rule and remove any duplicates.
+			wanted[key] = false
+			continue
+		}
+		stale = append(stale, f.RuleNumber)
+	}
+
+	for _, key := range keys {
+		if !wanted[key] {
+			continue
+		}
+		_, cidrBlock, err := net.ParseCIDR(key.cidrBlock)
+		if err != nil {
+			return fmt.Errorf("Error parsing %q for firewall rules (%s): %v", "cidr_block", d.Id(), err)
+		}
+		log.Printf("[INFO] Creating new firewall rule in group (%s)", d.Id())
+		if _, err := client.CreateFirewallRule(d.Id(), key.protocol, firewallRulePort(key.fromPort, key.toPort), cidrBlock, key.notes); err != nil {
+			return fmt.Errorf("Error creating firewall rule in group (%s): %v", d.Id(), err)
+		}
+	}
+
+	// New rules are added to the end of the list, so the stale rules keep their numbers.
+	return deleteFirewallRules(client, d.Id(), stale)
+}
+
+// deleteFirewallRules deletes the rules with the given numbers from the firewall group.
+// The rules are deleted from the highest number down so that deleting a rule
+// does not change the numbers of the rules that are still to be deleted.
+func deleteFirewallRules(client *Client, firewallGroupID string, numbers []int) error {
+	sort.Sort(sort.Reverse(sort.IntSlice(numbers)))
+	for _, n := range numbers {
+		log.Printf("[INFO] Destroying firewall rule (%s/%d)", firewallGroupID, n)
+		if err := client.DeleteFirewallRule(n, firewallGroupID); err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("Error destroying firewall rule (%s/%d): %v", firewallGroupID, n, err)
+		}
+	}
+	return nil
+}
+
+// expandFirewallRuleKey returns the key of a configured rule block.
+func expandFirewallRuleKey(rule map[string]interface{}) (firewallRuleKey, error) {
+	key := firewallRuleKey{
+		cidrBlock: rule["cidr_block"].(string),
+		fromPort:  rule["from_port"].(int),
+		notes:     rule["notes"].(string),
+		protocol:  rule["protocol"].(string),
+		toPort:    rule["to_port"].(int),
+	}
+	if (key.fromPort == 0) != (key.toPort == 0) {
+		return key, fmt.Errorf("expected %q and %q to both be provided or both be empty", "from_port", "to_port")
+	}
+	if (key.protocol == "tcp" || key.protocol == "udp") && key.fromPort == 0 {
+		return key, fmt.Errorf("%q and %q are required for protocol of type %q", "from_port", "to_port", key.protocol)
+	}
+	return key, nil
+}
+
+// newFirewallRuleKey returns the key of a rule returned by the API.
+func newFirewallRuleKey(f lib.FirewallRule) (firewallRuleKey, error) {
+	from, to, err := splitFirewallRule(f.Port)
+	if err != nil {
+		return firewallRuleKey{}, err
+	}
+	return firewallRuleKey{
+		cidrBlock: f.Network.String(),
+		fromPort:  from,
+		notes:     f.Notes,
+		protocol:  f.Protocol,
+		toPort:    to,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceFirewallRules(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	rules := `
+	rule {
+		cidr_block = "0.0.0.0/0"
+		from_port  = 8000
+		notes      = "web"
+		protocol   = "tcp"
+		to_port    = 9000
+	}
+
+	rule {
+		cidr_block = "192.168.0.0/16"
+		protocol   = "gre"
+	}
+
+	rule {
+		cidr_block = "::/0"
+		from_port  = 443
+		protocol   = "tcp"
+		to_port    = 443
+	}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_firewall_rules", func(id string) bool {
+			return len(api.firewallRules[id]) != 0
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceFirewallRulesConfig(`
+	rule {
+		cidr_block = "10.0.0.0/8"
+		from_port  = 22
+		protocol   = "tcp"
+		to_port    = 22
+	}
+
+	rule {
+		cidr_block = "0.0.0.0/0"
+		from_port  = 8000
+		notes      = "web"
+		protocol   = "tcp"
+		to_port    = 9000
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_firewall_rules.test", "rule.#", "2"),
+					api.checkCalls("firewall/rule_create", 2),
+				),
+			},
+			{
+				// Add a rule outside of Terraform, which must be removed
+				// along with the rule that is no longer configured.
+				PreConfig: func() {
+					api.mu.Lock()
+					defer api.mu.Unlock()
+					for id := range api.firewallGroups {
+						api.firewallRules[id] = append(api.firewallRules[id], fakeObject{
+							"ip_type":     "v4",
+							"action":      "accept",
+							"protocol":    "icmp",
+							"port":        "",
+							"subnet":      "0.0.0.0",
+							"subnet_size": 0,
+							"notes":       "",
+						})
+					}
+				},
+				Config: api.providerConfig() + testAccResourceFirewallRulesConfig(rules),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_firewall_rules.test", "rule.#", "3"),
+					api.checkCalls("firewall/rule_create", 4),
+					api.checkCalls("firewall/rule_delete", 2),
+				),
+			},
+			{
+				Config:            api.providerConfig() + testAccResourceFirewallRulesConfig(rules),
+				ResourceName:      "vultr_firewall_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceFirewallRulesConfig(rules string) string {
+	return fmt.Sprintf(`
+resource "vultr_firewall_group" "test" {
+	description = "test"
+}
+
+resource "vultr_firewall_rules" "test" {
+	firewall_group_id = "${vultr_firewall_group.test.id}"
+%s}
+`, rules)
+}
@@ -6,12 +6,15 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/JamesClonk/vultr/lib"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 const (
+	osIDCustom   = 159
 	osIDSnapshot = 164
 )
 
@@ -24,6 +27,12 @@ func resourceInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: resourceInstanceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"application_id": {
@@ -35,7 +44,49 @@ func resourceInstance() *schema.Resource {
 			"auto_backups": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+			},
+
+			// backup_schedule is not computed, so that removing it can be told apart from keeping it
+			// when auto_backups is disabled. It is only read back while it is set.
+			"backup_schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day_of_month": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateIntBetween(1, 28),
+						},
+
+						"day_of_week": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateIntBetween(0, 6),
+						},
+
+						"hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateIntBetween(0, 23),
+						},
+
+						"next_scheduled_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateBackupScheduleType,
+						},
+					},
+				},
 			},
 
 			"cost_per_month": {
@@ -49,6 +100,8 @@ func resourceInstance() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"deletion_protection": deletionProtectionSchema(),
+
 			"disk": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -102,6 +155,16 @@ func resourceInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"iso_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"iso_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -141,7 +204,13 @@ func resourceInstance() *schema.Resource {
 			"plan_id": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
+			},
+
+			"power_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePowerState,
 			},
 
 			"power_status": {
@@ -166,6 +235,18 @@ func resourceInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"restore_backup_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"restore_snapshot_id"},
+			},
+
+			"restore_snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"restore_backup_id"},
+			},
+
 			"server_state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -235,6 +316,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		FirewallGroupID:      d.Get("firewall_group_id").(string),
 		Hostname:             d.Get("hostname").(string),
 		IPV6:                 d.Get("ipv6").(bool),
+		ISO:                  d.Get("iso_id").(int),
 		PrivateNetworking:    d.Get("private_networking").(bool),
 		Script:               d.Get("startup_script_id").(int),
 		Snapshot:             d.Get("snapshot_id").(string),
@@ -242,6 +324,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		UserData:             d.Get("user_data").(string),
 	}
 
+	// Waiting for the new instance reads its default backup schedule into the state, so keep the configured one.
+	_, backupScheduleOK := d.GetOk("backup_schedule")
+	backupSchedule := expandBackupSchedule(d)
+
 	name := d.Get("name").(string)
 	var osID int
 	if snapshotOK {
@@ -271,13 +357,34 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(instance.ID)
 
-	if _, err := waitForResourceState(d, meta, "instance", "status", resourceInstanceRead, "active", []string{"pending"}); err != nil {
+	if _, err := waitForResourceState(d, meta, schema.TimeoutCreate, "instance", "status", resourceInstanceRead, "active", []string{"pending"}); err != nil {
 		return err
 	}
-	if _, err := waitForResourceState(d, meta, "instance", "power_status", resourceInstanceRead, "running", []string{"starting", "stopped"}); err != nil {
+	if _, err := waitForResourceState(d, meta, schema.TimeoutCreate, "instance", "power_status", resourceInstanceRead, "running", []string{"starting", "stopped"}); err != nil {
 		return err
 	}
 
+	// The API only attaches the ISO to new instances that install a custom OS. The options are
+	// used because waiting for the instance has already read the unattached ISO into the state.
+	if options.ISO != 0 && osID != osIDCustom {
+		if err := attachInstanceISO(d, meta, options.ISO, schema.TimeoutCreate); err != nil {
+			return err
+		}
+	}
+
+	if backupScheduleOK {
+		if err := setInstanceBackupSchedule(d, meta, backupSchedule); err != nil {
+			return err
+		}
+	}
+
+	// Instances are always started after they are created, so halt them if they should be stopped.
+	if d.Get("power_state").(string) == "stopped" {
+		if err := setInstancePowerState(d, meta, "stopped", schema.TimeoutCreate); err != nil {
+			return err
+		}
+	}
+
 	return resourceInstanceRead(d, meta)
 }
 
@@ -286,7 +393,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	instance, err := client.GetServer(d.Id())
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "Invalid server") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing instance (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -350,8 +457,40 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	isoStatus, err := client.GetISOStatusofServer(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting ISO status for instance (%s): %v", d.Id(), err)
+	}
+	var isoID int
+	if isoStatus.ISOID != "" {
+		isoID, err = strconv.Atoi(isoStatus.ISOID)
+		if err != nil {
+			return fmt.Errorf("ISO ID must be an integer: %v", err)
+		}
+	}
+
+	autoBackups := instance.AutoBackups == "yes"
+	var backupSchedule []map[string]interface{}
+	_, backupScheduleOK := d.GetOk("backup_schedule")
+	if autoBackups && backupScheduleOK {
+		bs, err := client.BackupGetSchedule(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error getting backup schedule for instance (%s): %v", d.Id(), err)
+		}
+		backupSchedule = append(backupSchedule, map[string]interface{}{
+			"day_of_month":        bs.Dom,
+			"day_of_week":         bs.Dow,
+			"hour":                bs.Hour,
+			"next_scheduled_time": bs.NextScheduledTimeUtc,
+			"type":                bs.CronType,
+		})
+	}
+
 	d.Set("application_id", instance.AppID)
-	d.Set("auto_backups", instance.AutoBackups)
+	d.Set("auto_backups", autoBackups)
+	if !autoBackups || backupScheduleOK {
+		d.Set("backup_schedule", backupSchedule)
+	}
 	d.Set("cost_per_month", instance.Cost)
 	d.Set("default_password", instance.DefaultPassword)
 	d.Set("disk", instance.Disk)
@@ -361,12 +500,15 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ipv4_mac", mainMac)
 	d.Set("ipv4_mask", instance.NetmaskV4)
 	d.Set("ipv4_private_cidr", fmt.Sprintf("%s/%d", instance.InternalIP, size))
+	d.Set("iso_id", isoID)
+	d.Set("iso_status", isoStatus.State)
 	d.Set("name", instance.Name)
 	d.Set("networks", nets)
 	d.Set("network_macs", netMACs)
 	d.Set("network_ids", networkIDs)
 	d.Set("os_id", osID)
 	d.Set("plan_id", instance.PlanID)
+	d.Set("power_state", instance.PowerStatus)
 	d.Set("power_status", instance.PowerStatus)
 	d.Set("ram", instance.RAM)
 	d.Set("region_id", instance.RegionID)
@@ -395,30 +537,71 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err := changeApplication(d.Id(), "instance", new.(string), client.ChangeApplicationofServer, client.ListApplicationsforServer); err != nil {
 			return err
 		}
-		if _, err := waitForResourceState(d, meta, "instance", "application_id", resourceInstanceRead, new.(string), []string{"", old.(string)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "application_id", resourceInstanceRead, new.(string), []string{"", old.(string)}); err != nil {
 			return err
 		}
 		d.SetPartial("application_id")
 	}
 
+	if d.HasChange("auto_backups") {
+		log.Printf("[INFO] Updating instance (%s) automatic backups", d.Id())
+		if d.Get("auto_backups").(bool) {
+			if err := client.EnableBackups(d.Id()); err != nil {
+				return fmt.Errorf("Error enabling automatic backups for instance (%s): %v", d.Id(), err)
+			}
+		} else {
+			if err := client.DisableBackups(d.Id()); err != nil {
+				return fmt.Errorf("Error disabling automatic backups for instance (%s): %v", d.Id(), err)
+			}
+		}
+		d.SetPartial("auto_backups")
+	}
+
+	if d.HasChange("backup_schedule") && d.Get("auto_backups").(bool) {
+		log.Printf("[INFO] Updating instance (%s) backup schedule", d.Id())
+		if err := setInstanceBackupSchedule(d, meta, expandBackupSchedule(d)); err != nil {
+			return err
+		}
+		d.SetPartial("backup_schedule")
+	}
+
 	if d.HasChange("firewall_group_id") {
 		log.Printf("[INFO] Updating instance (%s) firewall group", d.Id())
 		old, new := d.GetChange("firewall_group_id")
 		if err := client.SetFirewallGroup(d.Id(), new.(string)); err != nil {
 			return fmt.Errorf("Error changing instance (%s) firewall group to %q: %v", d.Id(), new.(string), err)
 		}
-		if _, err := waitForResourceState(d, meta, "instance", "firewall_group_id", resourceInstanceRead, new.(string), []string{old.(string)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "firewall_group_id", resourceInstanceRead, new.(string), []string{old.(string)}); err != nil {
 			return err
 		}
 	}
 
+	if d.HasChange("iso_id") {
+		log.Printf("[INFO] Updating instance (%s) ISO", d.Id())
+		old, new := d.GetChange("iso_id")
+		if old.(int) != 0 {
+			if err := client.DetachISOfromServer(d.Id()); err != nil {
+				return fmt.Errorf("Error detaching ISO %d from instance (%s): %v", old.(int), d.Id(), err)
+			}
+			if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "iso_status", resourceInstanceRead, "ready", []string{"isomounted", "isounmounting"}); err != nil {
+				return err
+			}
+		}
+		if new.(int) != 0 {
+			if err := attachInstanceISO(d, meta, new.(int), schema.TimeoutUpdate); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("iso_id")
+	}
+
 	if d.HasChange("name") {
 		log.Printf("[INFO] Updating instance (%s) name", d.Id())
 		old, new := d.GetChange("name")
 		if err := client.RenameServer(d.Id(), new.(string)); err != nil {
 			return fmt.Errorf("Error renaming instance (%s) to %q: %v", d.Id(), new.(string), err)
 		}
-		if _, err := waitForResourceState(d, meta, "instance", "name", resourceInstanceRead, new.(string), []string{"", old.(string)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "name", resourceInstanceRead, new.(string), []string{"", old.(string)}); err != nil {
 			return err
 		}
 		d.SetPartial("name")
@@ -456,19 +639,71 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err := changeOS(d.Id(), "instance", new.(int), client.ChangeOSofServer, client.ListOSforServer); err != nil {
 			return err
 		}
-		if _, err := waitForResourceState(d, meta, "instance", "os_id", resourceInstanceRead, strconv.FormatInt(int64(new.(int)), 10), []string{"", strconv.FormatInt(int64(old.(int)), 10)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "os_id", resourceInstanceRead, strconv.FormatInt(int64(new.(int)), 10), []string{"", strconv.FormatInt(int64(old.(int)), 10)}); err != nil {
 			return err
 		}
 		d.SetPartial("os_id")
 	}
 
+	if d.HasChange("plan_id") {
+		log.Printf("[INFO] Updating instance (%s) plan", d.Id())
+		old, new := d.GetChange("plan_id")
+		if err := client.ChangePlanOfServer(d.Id(), new.(int)); err != nil {
+			return fmt.Errorf("Error changing plan of instance (%s) to %d: %v", d.Id(), new.(int), err)
+		}
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "plan_id", resourceInstanceRead, strconv.Itoa(new.(int)), []string{strconv.Itoa(old.(int))}); err != nil {
+			return err
+		}
+		// Changing the plan reboots a running instance, so wait for it to come back.
+		if oldPower, newPower := d.GetChange("power_state"); oldPower.(string) != "stopped" && newPower.(string) != "stopped" {
+			if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "power_status", resourceInstanceRead, "running", []string{"starting", "stopped"}); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("plan_id")
+	}
+
+	if d.HasChange("power_state") {
+		log.Printf("[INFO] Updating instance (%s) power state", d.Id())
+		if err := setInstancePowerState(d, meta, d.Get("power_state").(string), schema.TimeoutUpdate); err != nil {
+			return err
+		}
+		d.SetPartial("power_state")
+	}
+
+	if d.HasChange("restore_backup_id") {
+		if id := d.Get("restore_backup_id").(string); id != "" {
+			log.Printf("[INFO] Restoring instance (%s) from backup %q", d.Id(), id)
+			if err := client.RestoreBackup(d.Id(), id); err != nil {
+				return fmt.Errorf("Error restoring instance (%s) from backup %q: %v", d.Id(), id, err)
+			}
+			if err := waitForInstanceRestore(d, meta); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("restore_backup_id")
+	}
+
+	if d.HasChange("restore_snapshot_id") {
+		if id := d.Get("restore_snapshot_id").(string); id != "" {
+			log.Printf("[INFO] Restoring instance (%s) from snapshot %q", d.Id(), id)
+			if err := client.RestoreSnapshot(d.Id(), id); err != nil {
+				return fmt.Errorf("Error restoring instance (%s) from snapshot %q: %v", d.Id(), id, err)
+			}
+			if err := waitForInstanceRestore(d, meta); err != nil {
+				return err
+			}
+		}
+		d.SetPartial("restore_snapshot_id")
+	}
+
 	if d.HasChange("tag") {
 		log.Printf("[INFO] Updating instance (%s) tag", d.Id())
 		old, new := d.GetChange("tag")
 		if err := client.TagServer(d.Id(), new.(string)); err != nil {
 			return fmt.Errorf("Error tagging instance (%s) with %q: %v", d.Id(), new.(string), err)
 		}
-		if _, err := waitForResourceState(d, meta, "instance", "tag", resourceInstanceRead, new.(string), []string{"", old.(string)}); err != nil {
+		if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "tag", resourceInstanceRead, new.(string), []string{"", old.(string)}); err != nil {
 			return err
 		}
 		d.SetPartial("tag")
@@ -482,35 +717,160 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
+	if err := checkDeletionProtection(d, "instance"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Destroying instance (%s)", d.Id())
 
-	for {
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		// The Vultr API does not allow us to directly check the a server's state if it is being destroyed.
 		// However, we can infer this state from the responses of the destroy endpoint.
 		// If there was no error, then we need to try destroying again.
-		if err := client.DeleteServer(d.Id()); err != nil {
-			// Server is locked and still being destroyed. We need to try again.
-			if err.Error() == "Unable to destroy server: Unable to remove VM: Server is currently locked" {
-				continue
-			}
+		err := client.DeleteServer(d.Id())
+		switch {
+		case err == nil:
+			return resource.RetryableError(fmt.Errorf("instance (%s) still exists", d.Id()))
+		// Server is locked, pending destruction, or we are being rate limited. We need to try again.
+		case isRetryableError(err):
+			return resource.RetryableError(err)
+		// Server does not exist so it has been deleted.
+		case isNotFoundError(err):
+			return nil
+		}
+		// There was a legitimate error.
+		return resource.NonRetryableError(err)
+	})
+	if err != nil {
+		return fmt.Errorf("Error destroying instance (%s): %v", d.Id(), err)
+	}
 
-			// Server is pending destruction. We need to wait and try again.
-			if err.Error() == "Unable to destroy server: Server is already pending destruction." {
-				continue
-			}
+	return nil
+}
 
-			// Server does not exist so it has been deleted.
-			if strings.HasPrefix(err.Error(), "Invalid server") {
-				break
-			}
-			// There was a legitimate error.
-			return fmt.Errorf("Error destroying instance (%s): %v", d.Id(), err)
+// setInstancePowerState starts or halts an instance and waits for its power status to match.
+func setInstancePowerState(d *schema.ResourceData, meta interface{}, state, timeout string) error {
+	client := meta.(*Client)
+
+	switch state {
+	case "running":
+		if err := client.StartServer(d.Id()); err != nil {
+			return fmt.Errorf("Error starting instance (%s): %v", d.Id(), err)
+		}
+		if _, err := waitForResourceState(d, meta, timeout, "instance", "power_status", resourceInstanceRead, "running", []string{"starting", "stopped"}); err != nil {
+			return err
+		}
+	case "stopped":
+		if err := client.HaltServer(d.Id()); err != nil {
+			return fmt.Errorf("Error halting instance (%s): %v", d.Id(), err)
+		}
+		if _, err := waitForResourceState(d, meta, timeout, "instance", "power_status", resourceInstanceRead, "stopped", []string{"running", "starting"}); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
+// waitForInstanceRestore waits for an instance that is being restored from a
+// snapshot or backup to become active and running again.
+func waitForInstanceRestore(d *schema.ResourceData, meta interface{}) error {
+	if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "server_state", resourceInstanceRead, "ok", []string{"locked", "installingbooting", "none"}); err != nil {
+		return err
+	}
+	if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "status", resourceInstanceRead, "active", []string{"pending"}); err != nil {
+		return err
+	}
+	if _, err := waitForResourceState(d, meta, schema.TimeoutUpdate, "instance", "power_status", resourceInstanceRead, "running", []string{"starting", "stopped"}); err != nil {
+		return err
+	}
 	return nil
 }
 
+// attachInstanceISO attaches an ISO to an instance and waits for it to be mounted.
+func attachInstanceISO(d *schema.ResourceData, meta interface{}, isoID int, timeout string) error {
+	client := meta.(*Client)
+
+	if err := client.AttachISOtoServer(d.Id(), isoID); err != nil {
+		return fmt.Errorf("Error attaching ISO %d to instance (%s): %v", isoID, d.Id(), err)
+	}
+	if _, err := waitForResourceState(d, meta, timeout, "instance", "iso_status", resourceInstanceRead, "isomounted", []string{"ready", "isomounting"}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// expandBackupSchedule returns the backup schedule in the configuration of an instance.
+func expandBackupSchedule(d *schema.ResourceData) lib.BackupSchedule {
+	return lib.BackupSchedule{
+		CronType: d.Get("backup_schedule.0.type").(string),
+		Dom:      d.Get("backup_schedule.0.day_of_month").(int),
+		Dow:      d.Get("backup_schedule.0.day_of_week").(int),
+		Hour:     d.Get("backup_schedule.0.hour").(int),
+	}
+}
+
+// setInstanceBackupSchedule sets the backup schedule of an instance.
+func setInstanceBackupSchedule(d *schema.ResourceData, meta interface{}, bs lib.BackupSchedule) error {
+	client := meta.(*Client)
+
+	if err := client.SetBackupSchedule(d.Id(), bs); err != nil {
+		return fmt.Errorf("Error setting backup schedule for instance (%s): %v", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	s := resourceInstance().Schema
+	if err := validateDeletionProtection(d, "instance", s); err != nil {
+		return err
+	}
+	if _, ok := d.GetOk("backup_schedule"); ok && !d.Get("auto_backups").(bool) && (d.Id() == "" || d.HasChange("backup_schedule") || d.HasChange("auto_backups")) {
+		return fmt.Errorf("%q requires %q to be enabled", "backup_schedule", "auto_backups")
+	}
+	// A replaced instance is created with the new plan, so it does not need to be an upgrade.
+	if _, replaced := forceNewChange(d, s); d.Id() != "" && d.HasChange("plan_id") && !replaced {
+		if err := validatePlanUpgrade(d.Id(), d.Get("plan_id").(int), meta.(*Client).ListUpgradePlansForServer); err != nil {
+			return err
+		}
+	}
+	if (d.HasChange("plan_id") || d.HasChange("region_id")) && d.NewValueKnown("plan_id") && d.NewValueKnown("region_id") {
+		if err := validatePlanAvailability("instance", d.Get("plan_id").(int), d.Get("region_id").(int), meta.(*Client).availablePlans); err != nil {
+			return err
+		}
+	}
+	if osID, ok := d.GetOk("os_id"); ok && d.HasChange("os_id") && d.NewValueKnown("os_id") {
+		if err := validateOSExists(osID.(int), meta.(*Client).operatingSystems); err != nil {
+			return err
+		}
+	}
+	if osID, ok := d.GetOk("os_id"); ok && (d.HasChange("os_id") || d.HasChange("plan_id")) && d.NewValueKnown("os_id") && d.NewValueKnown("plan_id") {
+		if err := validateOSPlan(osID.(int), d.Get("plan_id").(int), meta.(*Client).operatingSystems, meta.(*Client).planDetails); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePlanUpgrade ensures that an instance can be upgraded to the given plan in place.
+// If it cannot, it will return an error with the list of valid upgrade plans.
+func validatePlanUpgrade(id string, new int, list func(string) ([]int, error)) error {
+	plans, err := list(id)
+	if err != nil {
+		return fmt.Errorf("Error getting available upgrade plans for instance (%s): %v", id, err)
+	}
+	valid := make([]string, len(plans))
+	for i := range plans {
+		if plans[i] == new {
+			return nil
+		}
+		valid[i] = strconv.Itoa(plans[i])
+	}
+	if len(valid) == 0 {
+		return fmt.Errorf("Instance (%s) cannot be changed to plan %d: no upgrade plans are available; plans can only be upgraded, not downgraded", id, new)
+	}
+	return fmt.Errorf("Instance (%s) cannot be changed to plan %d; plans can only be upgraded, not downgraded. Valid upgrade plans are %s", id, new, strings.Join(valid, ", "))
+}
+
 // changeOS will try to change the OS of a instance or bare metal instance.
 // If there is an error, it will return an error with the list of valid OSs.
 func changeOS(id, resourceType string, new int, change func(string, int) error, list func(string) ([]lib.OS, error)) error {
@@ -0,0 +1,387 @@
+package vultr
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceInstance(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_instance", in(api.servers)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceInstanceConfig("test", "web", 201, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "name", "test"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "os_id", "167"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "plan_id", "201"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "power_status", "running"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "ram", "1024 MB"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "region_id", "1"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "status", "active"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "tag", "web"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "network_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("vultr_instance.test", "network_ids.0", "vultr_network.test", "id"),
+					resource.TestCheckResourceAttrPair("vultr_instance.test", "firewall_group_id", "vultr_firewall_group.test", "id"),
+					resource.TestCheckResourceAttrSet("vultr_instance.test", "ipv4_address"),
+					resource.TestCheckResourceAttrSet("vultr_instance.test", "ipv4_mac"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceConfig("renamed", "db", 202, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "plan_id", "202"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "ram", "2048 MB"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "tag", "db"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceConfig("renamed", "db", 202, "stopped"),
+				Check:  resource.TestCheckResourceAttr("vultr_instance.test", "power_status", "stopped"),
+			},
+			{
+				Config:                  api.providerConfig() + testAccResourceInstanceConfig("renamed", "db", 202, "stopped"),
+				ResourceName:            "vultr_instance.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccResourceInstanceDeletionProtection(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_instance", in(api.servers)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceInstanceDeletionProtectionConfig(true, 1),
+				Check:  resource.TestCheckResourceAttr("vultr_instance.test", "deletion_protection", "true"),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceInstanceDeletionProtectionConfig(true, 2),
+				ExpectError: regexp.MustCompile(`Cannot replace instance \(\d+\) to change "region_id" because "deletion_protection" is enabled`),
+			},
+			{
+				// Deletion protection must be disabled in a separate apply.
+				Config:      api.providerConfig() + testAccResourceInstanceDeletionProtectionConfig(false, 2),
+				ExpectError: regexp.MustCompile(`Cannot replace instance \(\d+\) to change "region_id" because "deletion_protection" is enabled`),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceInstanceDeletionProtectionConfig(true, 1),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Cannot destroy instance \(\d+\) because "deletion_protection" is enabled`),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceDeletionProtectionConfig(false, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "deletion_protection", "false"),
+					api.checkCalls("server/create", 1),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceDeletionProtectionConfig(false, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "region_id", "2"),
+					api.checkCalls("server/create", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceInstanceDeletionProtectionConfig(deletionProtection bool, regionID int) string {
+	return fmt.Sprintf(`
+resource "vultr_instance" "test" {
+	deletion_protection = %t
+	name                = "test"
+	os_id               = 167
+	plan_id             = 201
+	region_id           = %d
+}
+`, deletionProtection, regionID)
+}
+
+func TestAccResourceInstanceCatalog(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_instance", in(api.servers)),
+		Steps: []resource.TestStep{
+			{
+				Config:      api.providerConfig() + testAccResourceInstanceCatalogConfig(203, 2, 167),
+				ExpectError: regexp.MustCompile(`Plan 203 is not available for instance in region 2. Valid plans are 201, 202`),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceInstanceCatalogConfig(201, 2, 999),
+				ExpectError: regexp.MustCompile(`OS 999 does not exist. Valid OSs are 159 \(Custom\), 164 \(Snapshot\), 167 \(CentOS 7 x64\)`),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceInstanceCatalogConfig(201, 1, 240),
+				ExpectError: regexp.MustCompile(`OS 240 \(Windows 2016 x64\) cannot be installed on plan 201 because it does not support Windows. Valid plans are 203`),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceCatalogConfig(202, 1, 167),
+			},
+			{
+				Config:      api.providerConfig() + testAccResourceInstanceCatalogConfig(201, 1, 167),
+				ExpectError: regexp.MustCompile(`Instance \(\d+\) cannot be changed to plan 201; plans can only be upgraded`),
+			},
+			{
+				// Replacing the instance in another region may use a smaller plan.
+				Config: api.providerConfig() + testAccResourceInstanceCatalogConfig(201, 2, 167),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "plan_id", "201"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "region_id", "2"),
+					api.checkCalls("server/create", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInstanceBackups(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_instance", in(api.servers)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceInstanceBackupsConfig(true, `
+	backup_schedule {
+		type        = "weekly"
+		day_of_week = 3
+		hour        = 5
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "auto_backups", "true"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "backup_schedule.#", "1"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "backup_schedule.0.type", "weekly"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "backup_schedule.0.day_of_week", "3"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "backup_schedule.0.hour", "5"),
+					resource.TestCheckResourceAttrSet("vultr_instance.test", "backup_schedule.0.next_scheduled_time"),
+					api.checkCalls("server/backup_set_schedule", 1),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceBackupsConfig(true, `
+	backup_schedule {
+		type         = "monthly"
+		day_of_month = 15
+		hour         = 7
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "backup_schedule.0.type", "monthly"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "backup_schedule.0.day_of_month", "15"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "backup_schedule.0.hour", "7"),
+					api.checkCalls("server/backup_set_schedule", 2),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceBackupsConfig(false, `
+	backup_schedule {
+		type         = "monthly"
+		day_of_month = 15
+		hour         = 7
+	}`),
+				ExpectError: regexp.MustCompile(`"backup_schedule" requires "auto_backups" to be enabled`),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceBackupsConfig(false, `
+	backup_schedule {
+		type = "daily"
+	}`),
+				ExpectError: regexp.MustCompile(`"backup_schedule" requires "auto_backups" to be enabled`),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceBackupsConfig(false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "auto_backups", "false"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "backup_schedule.#", "0"),
+					api.checkCalls("server/backup_disable", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceInstanceBackupsConfig(autoBackups bool, backupSchedule string) string {
+	return fmt.Sprintf(`
+resource "vultr_instance" "test" {
+	auto_backups = %t
+	os_id        = 167
+	plan_id      = 201
+	region_id    = 1
+%s
+}
+`, autoBackups, backupSchedule)
+}
+
+func TestAccResourceInstanceRestore(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_instance", in(api.servers)),
+		Steps: []resource.TestStep{
+			{
+				Config:      api.providerConfig() + testAccResourceInstanceRestoreConfig("restore_backup_id = \"backup-1\"\n\trestore_snapshot_id = \"snapshot-1\""),
+				ExpectError: regexp.MustCompile(`"restore_backup_id": conflicts with restore_snapshot_id`),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceRestoreConfig(""),
+				Check:  api.checkCalls("server/create", 2),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceRestoreConfig(`restore_snapshot_id = "${vultr_snapshot.test.id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vultr_instance.test", "restore_snapshot_id", "vultr_snapshot.test", "id"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "server_state", "ok"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "power_status", "running"),
+					api.checkCalls("server/restore_snapshot", 1),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceRestoreConfig(`restore_backup_id = "backup-1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "restore_backup_id", "backup-1"),
+					api.checkCalls("server/restore_backup", 1),
+					api.checkCalls("server/restore_snapshot", 1),
+					api.checkCalls("server/create", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceInstanceRestoreConfig(restore string) string {
+	return fmt.Sprintf(`
+resource "vultr_instance" "source" {
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+}
+
+resource "vultr_snapshot" "test" {
+	description = "test"
+	instance_id = "${vultr_instance.source.id}"
+}
+
+resource "vultr_instance" "test" {
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+	%s
+}
+`, restore)
+}
+
+func TestAccResourceInstanceISO(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_instance", in(api.servers)),
+		Steps: []resource.TestStep{
+			{
+				// The ISO is attached after the instance is created because it does not install a custom OS.
+				Config: api.providerConfig() + testAccResourceInstanceISOConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vultr_iso.test", "id", "5000"),
+					resource.TestCheckResourceAttr("data.vultr_iso.test", "filename", "installer.iso"),
+					resource.TestCheckResourceAttr("data.vultr_iso.test", "size", "1048576"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "iso_id", "5000"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "iso_status", "isomounted"),
+					api.checkCalls("server/iso_attach", 1),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceISOConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "iso_id", "0"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "iso_status", "ready"),
+					api.checkCalls("server/iso_detach", 1),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceInstanceISOConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_instance.test", "iso_id", "5000"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "iso_status", "isomounted"),
+					api.checkCalls("server/iso_attach", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceInstanceISOConfig(iso bool) string {
+	isoID := ""
+	if iso {
+		isoID = `iso_id = "${data.vultr_iso.test.id}"`
+	}
+	return fmt.Sprintf(`
+data "vultr_iso" "test" {
+	name_regex = "^installer"
+}
+
+resource "vultr_instance" "test" {
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+	%s
+}
+`, isoID)
+}
+
+func testAccResourceInstanceCatalogConfig(planID, regionID, osID int) string {
+	return fmt.Sprintf(`
+resource "vultr_instance" "test" {
+	os_id     = %d
+	plan_id   = %d
+	region_id = %d
+}
+`, osID, planID, regionID)
+}
+
+func testAccResourceInstanceConfig(name, tag string, planID int, powerState string) string {
+	return fmt.Sprintf(`
+resource "vultr_firewall_group" "test" {
+	description = "test"
+}
+
+resource "vultr_network" "test" {
+	description = "test"
+	region_id   = 1
+}
+
+resource "vultr_instance" "test" {
+	firewall_group_id = "${vultr_firewall_group.test.id}"
+	name              = "%s"
+	network_ids       = ["${vultr_network.test.id}"]
+	os_id             = 167
+	plan_id           = %d
+	power_state       = "%s"
+	region_id         = 1
+	tag               = "%s"
+}
+`, name, planID, powerState, tag)
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/JamesClonk/vultr/lib"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -111,7 +110,7 @@ func resourceIPV4Read(d *schema.ResourceData, meta interface{}) error {
 
 	ips, err := client.ListIPv4(instance)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "Invalid server") {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing IPv4 address (%s) because the attached instance (%s) is gone", d.Id(), instance)
 			d.SetId("")
 			return nil
@@ -153,7 +152,7 @@ func resourceIPV4Delete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Destroying IPv4 address (%s)", d.Id())
 
-	if err := client.DeleteIPv4(instance, id); err != nil {
+	if err := client.DeleteIPv4(instance, id); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying IPv4 address (%s): %v", d.Id(), err)
 	}
 
@@ -0,0 +1,60 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceIPV4(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_ipv4", func(id string) bool {
+			instance, ip, err := parseStringSlashString(id, "IPv4 ID", "instance-id", "ip-address")
+			if err != nil {
+				return false
+			}
+			for _, i := range api.serverIPv4s[instance] {
+				if i["ip"] == ip {
+					return true
+				}
+			}
+			return false
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceIPV4Config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_ipv4.test", "gateway", "192.0.2.1"),
+					resource.TestCheckResourceAttr("vultr_ipv4.test", "netmask", "255.255.255.0"),
+					resource.TestCheckResourceAttrSet("vultr_ipv4.test", "ipv4_address"),
+					resource.TestCheckResourceAttrSet("vultr_ipv4.test", "reverse_dns"),
+				),
+			},
+			{
+				Config:                  api.providerConfig() + testAccResourceIPV4Config,
+				ResourceName:            "vultr_ipv4.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_id", "reboot"},
+			},
+		},
+	})
+}
+
+const testAccResourceIPV4Config = `
+resource "vultr_instance" "test" {
+	name      = "test"
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+}
+
+resource "vultr_ipv4" "test" {
+	instance_id = "${vultr_instance.test.id}"
+	reboot      = false
+}
+`
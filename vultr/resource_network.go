@@ -101,7 +101,7 @@ func resourceNetworkDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Destroying network (%s)", d.Id())
 
-	if err := client.DeleteNetwork(d.Id()); err != nil {
+	if err := client.DeleteNetwork(d.Id()); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying network (%s): %v", d.Id(), err)
 	}
 
@@ -0,0 +1,41 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceNetwork(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_network", in(api.networks)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceNetworkConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_network.test", "cidr_block", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr("vultr_network.test", "description", "test"),
+					resource.TestCheckResourceAttr("vultr_network.test", "region_id", "1"),
+				),
+			},
+			{
+				Config:            api.providerConfig() + testAccResourceNetworkConfig,
+				ResourceName:      "vultr_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceNetworkConfig = `
+resource "vultr_network" "test" {
+	cidr_block  = "10.0.0.0/24"
+	description = "test"
+	region_id   = 1
+}
+`
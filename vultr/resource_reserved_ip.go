@@ -3,7 +3,6 @@ package vultr
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/JamesClonk/vultr/lib"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +17,7 @@ func resourceReservedIP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceReservedIPCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"attached_id": {
@@ -30,6 +30,8 @@ func resourceReservedIP() *schema.Resource {
 				Computed: true,
 			},
 
+			"deletion_protection": deletionProtectionSchema(),
+
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -88,7 +90,7 @@ func resourceReservedIPRead(d *schema.ResourceData, meta interface{}) error {
 
 	rip, err := client.GetReservedIP(d.Id())
 	if err != nil {
-		if strings.HasPrefix(err.Error(), fmt.Sprintf("IP with ID %v not found", d.Id())) {
+		if isNotFoundError(err) {
 			log.Printf("[WARN] Removing reserved ip (%s) because it is gone", d.Id())
 			d.SetId("")
 			return nil
@@ -130,6 +132,10 @@ func resourceReservedIPUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceReservedIPDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*Client)
 
+	if err := checkDeletionProtection(d, "reserved IP"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Destroying reserved ip (%s)", d.Id())
 
 	aid := d.Get("attached_id").(string)
@@ -140,7 +146,7 @@ func resourceReservedIPDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := client.DestroyReservedIP(d.Id()); err != nil {
+	if err := client.DestroyReservedIP(d.Id()); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying reserved ip (%s): %v", d.Id(), err)
 	}
 
@@ -150,3 +156,7 @@ func resourceReservedIPDelete(d *schema.ResourceData, meta interface{}) error {
 func reservedIPToCIDR(rip lib.IP) string {
 	return fmt.Sprintf("%s/%d", rip.Subnet, rip.SubnetSize)
 }
+
+func resourceReservedIPCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return validateDeletionProtection(d, "reserved IP", resourceReservedIP().Schema)
+}
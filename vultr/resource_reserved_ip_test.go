@@ -0,0 +1,58 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceReservedIP(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_reserved_ip", in(api.reservedIPs)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceReservedIPConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_reserved_ip.test", "attached_id", ""),
+					resource.TestCheckResourceAttr("vultr_reserved_ip.test", "name", "test"),
+					resource.TestCheckResourceAttr("vultr_reserved_ip.test", "region_id", "1"),
+					resource.TestCheckResourceAttr("vultr_reserved_ip.test", "type", "v4"),
+					resource.TestCheckResourceAttrSet("vultr_reserved_ip.test", "cidr"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceReservedIPConfig("${vultr_instance.test.id}"),
+				Check:  resource.TestCheckResourceAttrPair("vultr_reserved_ip.test", "attached_id", "vultr_instance.test", "id"),
+			},
+			{
+				Config:                  api.providerConfig() + testAccResourceReservedIPConfig("${vultr_instance.test.id}"),
+				ResourceName:            "vultr_reserved_ip.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func testAccResourceReservedIPConfig(attachedID string) string {
+	return fmt.Sprintf(`
+resource "vultr_instance" "test" {
+	name      = "test"
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+}
+
+resource "vultr_reserved_ip" "test" {
+	attached_id = "%s"
+	name        = "test"
+	region_id   = 1
+}
+`, attachedID)
+}
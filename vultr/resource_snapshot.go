@@ -0,0 +1,141 @@
+package vultr
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/JamesClonk/vultr/lib"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSnapshotCreate,
+		Read:   resourceSnapshotRead,
+		Delete: resourceSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// The API does not report which instance a snapshot was taken of, so instance_id is
+			// empty after an import and setting it must not replace the imported snapshot.
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+
+			"os_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	instanceID := d.Get("instance_id").(string)
+	description := d.Get("description").(string)
+
+	log.Printf("[INFO] Creating new snapshot of instance (%s)", instanceID)
+	snapshot, err := client.CreateSnapshot(instanceID, description)
+	if err != nil {
+		return fmt.Errorf("Error creating snapshot of instance (%s): %v", instanceID, err)
+	}
+	d.SetId(snapshot.ID)
+
+	if _, err := waitForResourceState(d, meta, schema.TimeoutCreate, "snapshot", "status", resourceSnapshotRead, "complete", []string{"pending"}); err != nil {
+		return err
+	}
+
+	return resourceSnapshotRead(d, meta)
+}
+
+func resourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	snapshots, err := client.GetSnapshots()
+	if err != nil {
+		return fmt.Errorf("Error getting snapshots: %v", err)
+	}
+
+	var snapshot *lib.Snapshot
+	for i := range snapshots {
+		if snapshots[i].ID == d.Id() {
+			snapshot = &snapshots[i]
+			break
+		}
+	}
+
+	if snapshot == nil {
+		log.Printf("[WARN] Removing snapshot (%s) because it is gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var osID int
+	if snapshot.OSID != "" {
+		osID, err = strconv.Atoi(snapshot.OSID)
+		if err != nil {
+			return fmt.Errorf("OS ID must be an integer: %v", err)
+		}
+	}
+
+	d.Set("application_id", snapshot.AppID)
+	d.Set("created", snapshot.Created)
+	d.Set("description", snapshot.Description)
+	d.Set("os_id", osID)
+	d.Set("size", snapshot.Size)
+	d.Set("status", snapshot.Status)
+
+	return nil
+}
+
+func resourceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	log.Printf("[INFO] Destroying snapshot (%s)", d.Id())
+
+	if err := client.DeleteSnapshot(d.Id()); err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("Error destroying snapshot (%s): %v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestAccResourceSnapshot(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_snapshot", in(api.snapshots)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceSnapshotConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_snapshot.test", "description", "test"),
+					resource.TestCheckResourceAttr("vultr_snapshot.test", "os_id", "167"),
+					resource.TestCheckResourceAttr("vultr_snapshot.test", "status", "complete"),
+				),
+			},
+			{
+				// The API does not report instance_id; TestResourceSnapshotImportedDiff checks that this does not replace the snapshot.
+				Config:                  api.providerConfig() + testAccResourceSnapshotConfig,
+				ResourceName:            "vultr_snapshot.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_id"},
+			},
+		},
+	})
+}
+
+const testAccResourceSnapshotConfig = `
+resource "vultr_instance" "test" {
+	name      = "test"
+	os_id     = 167
+	plan_id   = 201
+	region_id = 1
+}
+
+resource "vultr_snapshot" "test" {
+	description = "test"
+	instance_id = "${vultr_instance.test.id}"
+}
+`
+
+func TestResourceSnapshotImportedDiff(t *testing.T) {
+	r := resourceSnapshot()
+	// An imported snapshot has no instance_id because the API does not report it.
+	state := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			"id":          "1",
+			"description": "test",
+			"os_id":       "167",
+			"size":        "26843545600",
+			"status":      "complete",
+		},
+	}
+	config := terraform.NewResourceConfigShimmed(cty.ObjectVal(map[string]cty.Value{
+		"application_id": cty.NullVal(cty.String),
+		"created":        cty.NullVal(cty.String),
+		"description":    cty.StringVal("test"),
+		"id":             cty.NullVal(cty.String),
+		"instance_id":    cty.StringVal("1001"),
+		"os_id":          cty.NullVal(cty.Number),
+		"size":           cty.NullVal(cty.String),
+		"status":         cty.NullVal(cty.String),
+	}), r.CoreConfigSchema())
+
+	diff, err := r.Diff(state, config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Errorf("expected an empty plan for an imported snapshot, got %v", diff)
+	}
+}
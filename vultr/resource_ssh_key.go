@@ -102,7 +102,7 @@ func resourceSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Destroying SSH key (%s)", d.Id())
 
-	if err := client.DeleteSSHKey(d.Id()); err != nil {
+	if err := client.DeleteSSHKey(d.Id()); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying SSH key (%s): %v", d.Id(), err)
 	}
 
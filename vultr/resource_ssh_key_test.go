@@ -0,0 +1,49 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceSSHKey(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_ssh_key", in(api.sshKeys)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceSSHKeyConfig("test", "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC1 test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_ssh_key.test", "name", "test"),
+					resource.TestCheckResourceAttr("vultr_ssh_key.test", "public_key", "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC1 test@example.com"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceSSHKeyConfig("renamed", "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC2 test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_ssh_key.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("vultr_ssh_key.test", "public_key", "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC2 test@example.com"),
+				),
+			},
+			{
+				Config:            api.providerConfig() + testAccResourceSSHKeyConfig("renamed", "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC2 test@example.com"),
+				ResourceName:      "vultr_ssh_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSSHKeyConfig(name, key string) string {
+	return fmt.Sprintf(`
+resource "vultr_ssh_key" "test" {
+	name       = "%s"
+	public_key = "%s"
+}
+`, name, key)
+}
@@ -31,6 +31,7 @@ func resourceStartupScript() *schema.Resource {
 
 			"type": {
 				Type:         schema.TypeString,
+				Default:      "boot",
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validateStartupScriptType,
@@ -44,12 +45,7 @@ func resourceStartupScriptCreate(d *schema.ResourceData, meta interface{}) error
 
 	content := d.Get("content").(string)
 	name := d.Get("name").(string)
-	var scriptType string
-	if _, typeOk := d.GetOk("type"); typeOk {
-		scriptType = d.Get("type").(string)
-	} else {
-		scriptType = "boot"
-	}
+	scriptType := d.Get("type").(string)
 
 	log.Printf("[INFO] Creating new startup script")
 	script, err := client.CreateStartupScript(name, content, scriptType)
@@ -105,7 +101,7 @@ func resourceStartupScriptDelete(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[INFO] Destroying startup script (%s)", d.Id())
 
-	if err := client.DeleteStartupScript(d.Id()); err != nil {
+	if err := client.DeleteStartupScript(d.Id()); err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("Error destroying startup script (%s): %v", d.Id(), err)
 	}
 
@@ -0,0 +1,50 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccResourceStartupScript(t *testing.T) {
+	api := newFakeAPI()
+	defer api.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: api.checkDestroy("vultr_startup_script", in(api.scripts)),
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccResourceStartupScriptConfig("test", "#!/bin/sh\\necho hello"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_startup_script.test", "name", "test"),
+					resource.TestCheckResourceAttr("vultr_startup_script.test", "content", "#!/bin/sh\necho hello"),
+					resource.TestCheckResourceAttr("vultr_startup_script.test", "type", "boot"),
+				),
+			},
+			{
+				Config: api.providerConfig() + testAccResourceStartupScriptConfig("renamed", "#!/bin/sh\\necho goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vultr_startup_script.test", "name", "renamed"),
+					resource.TestCheckResourceAttr("vultr_startup_script.test", "content", "#!/bin/sh\necho goodbye"),
+				),
+			},
+			{
+				Config:            api.providerConfig() + testAccResourceStartupScriptConfig("renamed", "#!/bin/sh\\necho goodbye"),
+				ResourceName:      "vultr_startup_script.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceStartupScriptConfig(name, content string) string {
+	return fmt.Sprintf(`
+resource "vultr_startup_script" "test" {
+	name    = "%s"
+	content = "%s"
+}
+`, name, content)
+}
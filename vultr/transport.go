@@ -0,0 +1,131 @@
+package vultr
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/time/rate"
+)
+
+// retryableStatusCodes are the API response status codes that indicate
+// that a request can be retried without further action.
+var retryableStatusCodes = map[int]struct{}{
+	http.StatusTooManyRequests:     {},
+	http.StatusInternalServerError: {},
+	http.StatusBadGateway:          {},
+	http.StatusServiceUnavailable:  {},
+	http.StatusGatewayTimeout:      {},
+}
+
+// rejectedStatusCodes are the retryable status codes that indicate that the API
+// did not process the request. Only these are retried for requests that are not
+// idempotent, such as creating a server, which may have succeeded despite a
+// server error.
+var rejectedStatusCodes = map[int]struct{}{
+	http.StatusTooManyRequests:    {},
+	http.StatusServiceUnavailable: {},
+}
+
+// retryTransport is an http.RoundTripper that retries requests that failed
+// because of rate limiting or transient server errors with exponential backoff.
+// Like any http.RoundTripper, it returns the last response once it stops
+// retrying, whatever its status; callers turn unsuccessful responses into errors.
+type retryTransport struct {
+	next       http.RoundTripper
+	limiter    *rate.Limiter
+	maxRetries int
+	maxWait    time.Duration
+	// onResponse is called with every response, including those that are retried.
+	onResponse func(*http.Request, *http.Response)
+}
+
+// newHTTPClient returns an HTTP client that makes at most one request every rateLimit
+// and retries failed requests at most maxRetries times, waiting at most maxWait between attempts.
+func newHTTPClient(rateLimit time.Duration, maxRetries int, maxWait time.Duration, onResponse func(*http.Request, *http.Response)) *http.Client {
+	transport := cleanhttp.DefaultPooledTransport()
+	// The Vultr API does not cope well with HTTP/2, so disable it like JamesClonk/vultr/lib does.
+	transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	return &http.Client{
+		Transport: &retryTransport{
+			next:       transport,
+			limiter:    rate.NewLimiter(rate.Every(rateLimit), 1),
+			maxRetries: maxRetries,
+			maxWait:    maxWait,
+			onResponse: onResponse,
+		},
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		if t.onResponse != nil {
+			t.onResponse(req, resp)
+		}
+		if resp.StatusCode == http.StatusOK {
+			return resp, nil
+		}
+		retryable := isRetryable(req, resp)
+		// The request body was consumed by the previous attempt,
+		// so we can only retry if we can get a fresh copy.
+		if !retryable || attempt >= t.maxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt, resp)
+		ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		log.Printf("[DEBUG] Vultr API request %s %s failed with status %d; retrying in %s", req.Method, req.URL.Path, resp.StatusCode, wait)
+		time.Sleep(wait)
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// isRetryable returns true if the request can be retried after the given response.
+func isRetryable(req *http.Request, resp *http.Response) bool {
+	codes := retryableStatusCodes
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		codes = rejectedStatusCodes
+	}
+	_, ok := codes[resp.StatusCode]
+	return ok
+}
+
+// backoff returns how long to wait before the next attempt. It honors the
+// Retry-After header if present and otherwise backs off exponentially with
+// jitter, never waiting longer than the configured maximum.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	var wait time.Duration
+	if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s > 0 {
+		wait = time.Duration(s) * time.Second
+	} else {
+		if attempt > 16 {
+			attempt = 16
+		}
+		wait = time.Duration(1<<uint(attempt)) * time.Second
+		wait += time.Duration(rand.Int63n(int64(wait) / 2))
+	}
+	if wait > t.maxWait {
+		wait = t.maxWait
+	}
+	return wait
+}
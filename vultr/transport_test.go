@@ -0,0 +1,139 @@
+package vultr
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	cases := []struct {
+		method     string
+		statuses   []int
+		maxRetries int
+		status     int
+		attempts   int
+	}{
+		{
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusOK},
+			maxRetries: 3,
+			status:     http.StatusOK,
+			attempts:   1,
+		},
+		{
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 3,
+			status:     http.StatusOK,
+			attempts:   3,
+		},
+		{
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 1,
+			status:     http.StatusServiceUnavailable,
+			attempts:   2,
+		},
+		{
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusBadRequest, http.StatusOK},
+			maxRetries: 3,
+			status:     http.StatusBadRequest,
+			attempts:   1,
+		},
+		{
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusInternalServerError, http.StatusOK},
+			maxRetries: 3,
+			status:     http.StatusInternalServerError,
+			attempts:   1,
+		},
+		{
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			maxRetries: 3,
+			status:     http.StatusOK,
+			attempts:   3,
+		},
+	}
+
+	for i, c := range cases {
+		var attempts int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := r.ParseForm(); err != nil || r.Form.Get("SUBID") != "123" {
+				t.Errorf("test case %d: expected request parameters to be sent on attempt %d, got %v", i, attempts+1, r.Form)
+			}
+			w.WriteHeader(c.statuses[attempts])
+			attempts++
+		}))
+
+		client := newHTTPClient(0, c.maxRetries, time.Millisecond, nil)
+		values := url.Values{"SUBID": {"123"}}
+		var resp *http.Response
+		var err error
+		if c.method == http.MethodGet {
+			resp, err = client.Get(server.URL + "?" + values.Encode())
+		} else {
+			resp, err = client.Post(server.URL, "application/x-www-form-urlencoded", strings.NewReader(values.Encode()))
+		}
+		if err != nil {
+			t.Fatalf("test case %d: unexpected error: %v", i, err)
+		}
+		ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		status := resp.StatusCode
+		server.Close()
+
+		if status != c.status {
+			t.Errorf("test case %d: expected status %d, got %d", i, c.status, status)
+		}
+		if attempts != c.attempts {
+			t.Errorf("test case %d: expected %d attempts, got %d", i, c.attempts, attempts)
+		}
+	}
+}
+
+func TestRetryTransportRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := newHTTPClient(50*time.Millisecond, 0, time.Millisecond, nil)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected 3 requests to take at least %s, took %s", 100*time.Millisecond, elapsed)
+	}
+}
+
+func TestClientReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		w.Write([]byte("Invalid server."))
+	}))
+	defer server.Close()
+
+	config := Config{APIKey: "test", Endpoint: server.URL + "/"}
+	meta, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = meta.(*Client).EnableBackups("123")
+	e, ok := err.(*apiError)
+	if !ok {
+		t.Fatalf("expected an API error, got %#v", err)
+	}
+	if e.statusCode != http.StatusPreconditionFailed || e.message != "Invalid server." {
+		t.Errorf("expected status %d and message %q, got %d and %q", http.StatusPreconditionFailed, "Invalid server.", e.statusCode, e.message)
+	}
+}
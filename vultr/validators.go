@@ -3,9 +3,11 @@ package vultr
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -53,6 +55,77 @@ func validateReservedIPType(v interface{}, k string) (ws []string, errors []erro
 	return
 }
 
+// validatePowerState ensures that the string value is either "running" or "stopped".
+func validatePowerState(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "running" && value != "stopped" {
+		errors = append(errors, fmt.Errorf("%q must be either 'running' or 'stopped'", k))
+		return
+	}
+	return
+}
+
+// validateBackupScheduleType ensures that the string value is a valid
+// backup schedule type and returns an error otherwise.
+func validateBackupScheduleType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := map[string]struct{}{
+		"daily":          {},
+		"daily_alt_even": {},
+		"daily_alt_odd":  {},
+		"monthly":        {},
+		"weekly":         {},
+	}
+	if _, ok := validTypes[value]; !ok {
+		errors = append(errors, fmt.Errorf("%q contains an invalid backup schedule type %q; valid types are: %q, %q, %q, %q, and %q", k, value, "daily", "daily_alt_even", "daily_alt_odd", "monthly", "weekly"))
+	}
+	return
+}
+
+// validateIntBetween returns a validation function that ensures that the
+// int value is between min and max, inclusive, and returns an error otherwise.
+func validateIntBetween(min, max int) func(interface{}, string) ([]string, []error) {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		if value < min || value > max {
+			errors = append(errors, fmt.Errorf("%q must be between %d and %d, inclusive, got %d", k, min, max, value))
+		}
+		return
+	}
+}
+
+// validateRequestLogging ensures that the string value is a valid
+// request logging mode and returns an error otherwise.
+func validateRequestLogging(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != requestLoggingFull && value != requestLoggingHeaders && value != requestLoggingOff {
+		errors = append(errors, fmt.Errorf("%q must be one of %q, %q, or %q", k, requestLoggingFull, requestLoggingHeaders, requestLoggingOff))
+	}
+	return
+}
+
+// validateFilterOperator ensures that the string value is a valid
+// data source filter operator and returns an error otherwise.
+func validateFilterOperator(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	for _, o := range filterOperators {
+		if value == o {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%q contains an invalid filter operator %q; valid operators are: %s", k, value, strings.Join(filterOperators, ", ")))
+	return
+}
+
+// validateSortOrder ensures that the string value is either "asc" or "desc".
+func validateSortOrder(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "asc" && value != "desc" {
+		errors = append(errors, fmt.Errorf("%q must be either 'asc' or 'desc'", k))
+	}
+	return
+}
+
 // validateFirewallRuleProtocol ensures that the string value is a valid
 // firewall rule protocol and returns an error otherwise.
 func validateFirewallRuleProtocol(v interface{}, k string) (ws []string, errors []error) {
@@ -69,6 +142,43 @@ func validateFirewallRuleProtocol(v interface{}, k string) (ws []string, errors
 	return
 }
 
+// dnsRecordTypes are the DNS record types supported by the Vultr API.
+var dnsRecordTypes = []string{"A", "AAAA", "CAA", "CNAME", "MX", "NS", "SRV", "SSHFP", "TXT"}
+
+// validateDNSRecordType ensures that the string value is a supported
+// DNS record type and returns an error otherwise.
+func validateDNSRecordType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	for _, t := range dnsRecordTypes {
+		if value == t {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%q contains an invalid DNS record type %q; valid types are: %s", k, value, strings.Join(dnsRecordTypes, ", ")))
+	return
+}
+
+// validateCAATag ensures that the string value is a valid CAA record tag.
+func validateCAATag(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "issue" && value != "issuewild" && value != "iodef" {
+		errors = append(errors, fmt.Errorf("%q must be one of 'issue', 'issuewild' or 'iodef', got %q", k, value))
+	}
+	return
+}
+
+// hostnameRegexp matches fully qualified domain names, with an optional trailing dot.
+var hostnameRegexp = regexp.MustCompile(`^([A-Za-z0-9_]([A-Za-z0-9_-]{0,61}[A-Za-z0-9])?\.)+[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.?$`)
+
+// validateHostname ensures that the string value is a fully qualified domain name.
+func validateHostname(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 253 || !hostnameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name, got %q", k, value))
+	}
+	return
+}
+
 // validateStartupScriptType ensures that the string value is a valid
 // startup script type and returns an error otherwise.
 func validateStartupScriptType(v interface{}, k string) (ws []string, errors []error) {
@@ -83,6 +193,34 @@ func validateStartupScriptType(v interface{}, k string) (ws []string, errors []e
 	return
 }
 
+// validateDuration ensures that the string value is a valid, non-negative duration.
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid duration: %v", k, err))
+		return
+	}
+	if d < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative, got %q", k, value))
+	}
+	return
+}
+
+// validateURL ensures that the string value is a valid absolute HTTP or HTTPS URL.
+func validateURL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	u, err := url.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid URL: %v", k, err))
+		return
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errors = append(errors, fmt.Errorf("%q must be an absolute HTTP or HTTPS URL, got %q", k, value))
+	}
+	return
+}
+
 // validateRegex ensures that the string is a valid regular expression.
 func validateRegex(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
@@ -10,16 +10,25 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func waitForResourceState(d *schema.ResourceData, meta interface{}, resourceName, prop string, readFunc schema.ReadFunc, target string, pending []string) (interface{}, error) {
+// waitDelay and waitMinTimeout control how often the state of a resource is polled.
+// They are variables so that tests against a fake API do not need to wait.
+var (
+	waitDelay      = 10 * time.Second
+	waitMinTimeout = 3 * time.Second
+)
+
+// waitForResourceState waits for the given property of a resource to reach the target value.
+// The timeout is the key of the resource timeout, e.g. schema.TimeoutCreate, for the operation that is waiting.
+func waitForResourceState(d *schema.ResourceData, meta interface{}, timeout, resourceName, prop string, readFunc schema.ReadFunc, target string, pending []string) (interface{}, error) {
 	log.Printf("[INFO] Waiting for %s (%s) to update %s to %q", resourceName, d.Id(), prop, target)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    pending,
 		Target:     []string{target},
 		Refresh:    resourceStateRefreshFunc(d, meta, prop, readFunc),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:    d.Timeout(timeout),
+		Delay:      waitDelay,
+		MinTimeout: waitMinTimeout,
 	}
 
 	state, err := stateConf.WaitForState()